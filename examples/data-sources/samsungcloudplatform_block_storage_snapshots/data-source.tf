@@ -0,0 +1,10 @@
+data "samsungcloudplatform_block_storages" "my_scp_block_storages" {
+}
+
+data "samsungcloudplatform_block_storage_snapshots" "my_scp_block_storage_snapshots" {
+  block_storage_id = data.samsungcloudplatform_block_storages.my_scp_block_storages.contents[0].block_storage_id
+}
+
+output "output_my_scp_block_storage_snapshots" {
+  value = data.samsungcloudplatform_block_storage_snapshots.my_scp_block_storage_snapshots
+}
@@ -0,0 +1,10 @@
+data "samsungcloudplatform_bm_block_storages" "my_bm_block_storages" {
+}
+
+data "samsungcloudplatform_bm_block_storage_snapshots" "my_bm_block_storage_snapshots" {
+  bm_block_storage_id = data.samsungcloudplatform_bm_block_storages.my_bm_block_storages.contents[0].bare_metal_block_storage_id
+}
+
+output "output_my_bm_block_storage_snapshots" {
+  value = data.samsungcloudplatform_bm_block_storage_snapshots.my_bm_block_storage_snapshots
+}
@@ -0,0 +1,12 @@
+data "samsungcloudplatform_region" "region" {
+}
+
+data "samsungcloudplatform_hpc_lite_new_resource_pools" "pools" {
+  service_zone_id  = data.samsungcloudplatform_region.region.id
+  server_type      = "2023_001"
+  min_free_servers = 4
+}
+
+output "resource_pool_id" {
+  value = data.samsungcloudplatform_hpc_lite_new_resource_pools.pools.contents[0].resource_pool_id
+}
@@ -0,0 +1,17 @@
+data "samsungcloudplatform_kubernetes_kubeconfig" "kubeconfig" {
+  kubernetes_engine_id = "HSCLUSTER-XXXXXXXXXXXXX"
+  kubeconfig_type      = "public"
+  admin                = true
+}
+
+provider "kubernetes" {
+  host                   = data.samsungcloudplatform_kubernetes_kubeconfig.kubeconfig.host
+  cluster_ca_certificate = data.samsungcloudplatform_kubernetes_kubeconfig.kubeconfig.cluster_ca_certificate
+  client_certificate     = data.samsungcloudplatform_kubernetes_kubeconfig.kubeconfig.client_certificate
+  client_key             = data.samsungcloudplatform_kubernetes_kubeconfig.kubeconfig.client_key
+  token                  = data.samsungcloudplatform_kubernetes_kubeconfig.kubeconfig.token
+}
+
+output "kubeconfig_expires_at" {
+  value = data.samsungcloudplatform_kubernetes_kubeconfig.kubeconfig.expires_at
+}
@@ -0,0 +1,8 @@
+# Find all nodes of a kubernetes engine
+data "samsungcloudplatform_kubernetes_nodes" "my_scp_kubernetes_nodes" {
+  kubernetes_engine_id = "HSCLUSTER-XXXXXXXXX"
+}
+
+output "result_scp_kubernetes_nodes" {
+  value = data.samsungcloudplatform_kubernetes_nodes.my_scp_kubernetes_nodes
+}
@@ -0,0 +1,8 @@
+data "samsungcloudplatform_server_group" "my_server_group" {
+  server_group_name    = "ha-pair-sg"
+  affinity_policy_type = "ANTI_AFFINITY"
+}
+
+output "server_group_id" {
+  value = data.samsungcloudplatform_server_group.my_server_group.id
+}
@@ -15,6 +15,14 @@ data "samsungcloudplatform_standard_image" "ubuntu_image" {
   }
 }
 
+# Track the latest patched Ubuntu 22.04 or newer image
+data "samsungcloudplatform_standard_image" "latest_ubuntu_image" {
+  region             = data.samsungcloudplatform_region.region.location
+  os_distro          = "Ubuntu"
+  version_constraint = ">= 22.04"
+  most_recent        = true
+}
+
 output "result_scp_my_standard_image" {
   value = data.samsungcloudplatform_standard_image.ubuntu_image
 }
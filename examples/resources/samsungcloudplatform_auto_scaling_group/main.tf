@@ -19,4 +19,8 @@ resource "samsungcloudplatform_auto_scaling_group" "my_asg" {
     subnet_id       = data.terraform_remote_state.subnet.outputs.id
     nat_enabled     = false
   }
+  instance_refresh {
+    min_joined_percentage = 50
+    warmup_seconds        = 60
+  }
 }
@@ -0,0 +1,17 @@
+# hold new servers until the agent registered with service discovery
+resource "samsungcloudplatform_auto_scaling_group_lifecycle_hook" "launching" {
+  asg_id                    = data.terraform_remote_state.asg.outputs.id
+  lifecycle_hook_name       = "wait-registration"
+  lifecycle_transition      = "LAUNCHING"
+  heartbeat_timeout_seconds = var.heartbeat_timeout_seconds
+  default_result            = "ABANDON"
+}
+
+# drain servers before they are deleted
+resource "samsungcloudplatform_auto_scaling_group_lifecycle_hook" "terminating" {
+  asg_id                    = data.terraform_remote_state.asg.outputs.id
+  lifecycle_hook_name       = "drain"
+  lifecycle_transition      = "TERMINATING"
+  heartbeat_timeout_seconds = var.heartbeat_timeout_seconds
+  default_result            = "CONTINUE"
+}
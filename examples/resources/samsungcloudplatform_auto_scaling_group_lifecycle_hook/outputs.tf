@@ -0,0 +1,6 @@
+output "launching_id" {
+  value = samsungcloudplatform_auto_scaling_group_lifecycle_hook.launching.id
+}
+output "terminating_id" {
+  value = samsungcloudplatform_auto_scaling_group_lifecycle_hook.terminating.id
+}
@@ -0,0 +1,10 @@
+data "terraform_remote_state" "asg" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_auto_scaling_group/terraform.tfstate"
+  }
+}
+variable "heartbeat_timeout_seconds" {
+  default = 600
+}
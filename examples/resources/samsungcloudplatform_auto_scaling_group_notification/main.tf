@@ -0,0 +1,13 @@
+resource "samsungcloudplatform_auto_scaling_group_notification" "webhook" {
+  asg_id            = data.terraform_remote_state.asg.outputs.id
+  notification_type = "WEBHOOK"
+  webhook_url       = var.webhook_url
+  events            = ["LIFECYCLE_LAUNCHING", "LIFECYCLE_TERMINATING"]
+}
+
+resource "samsungcloudplatform_auto_scaling_group_notification" "email" {
+  asg_id            = data.terraform_remote_state.asg.outputs.id
+  notification_type = "EMAIL"
+  email_recipients  = var.email_recipients
+  events            = ["SCALE_OUT", "SCALE_IN", "LAUNCH_FAILED", "TERMINATE_FAILED"]
+}
@@ -0,0 +1,6 @@
+output "webhook_id" {
+  value = samsungcloudplatform_auto_scaling_group_notification.webhook.id
+}
+output "email_id" {
+  value = samsungcloudplatform_auto_scaling_group_notification.email.id
+}
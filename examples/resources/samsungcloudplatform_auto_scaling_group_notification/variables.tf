@@ -0,0 +1,13 @@
+data "terraform_remote_state" "asg" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_auto_scaling_group/terraform.tfstate"
+  }
+}
+variable "webhook_url" {
+  default = "https://hooks.example.com/asg"
+}
+variable "email_recipients" {
+  default = ["ops@example.com"]
+}
@@ -0,0 +1,13 @@
+# scale out on weekday mornings
+resource "samsungcloudplatform_auto_scaling_group_schedule" "my_asg_schedule" {
+  asg_id               = data.terraform_remote_state.asg.outputs.id
+  schedule_name        = var.name
+  min_server_count     = var.min_server_count
+  desired_server_count = var.desired_server_count
+  max_server_count     = var.max_server_count
+  frequency            = "WEEKLY"
+  day_of_week          = ["MON", "TUE", "WED", "THU", "FRI"]
+  execution_time       = "08:00"
+  start_date           = var.start_date
+  timezone             = "Asia/Seoul"
+}
@@ -0,0 +1,3 @@
+output "id" {
+  value = samsungcloudplatform_auto_scaling_group_schedule.my_asg_schedule.id
+}
@@ -0,0 +1,22 @@
+data "terraform_remote_state" "asg" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_auto_scaling_group/terraform.tfstate"
+  }
+}
+variable "name" {
+  default = "weekday-morning"
+}
+variable "min_server_count" {
+  default = 2
+}
+variable "desired_server_count" {
+  default = 10
+}
+variable "max_server_count" {
+  default = 10
+}
+variable "start_date" {
+  default = "2026-11-02"
+}
@@ -0,0 +1,15 @@
+resource "samsungcloudplatform_block_storage_snapshot" "my_bs_snapshot" {
+  block_storage_id = data.terraform_remote_state.bs.outputs.id
+  name             = var.name
+  description      = "snapshot of data volume"
+}
+
+resource "samsungcloudplatform_block_storage" "my_bs_clone" {
+  name            = "${var.name}-clone"
+  storage_size_gb = 10
+  product_name    = "SSD"
+  shared_type     = "DEDICATED"
+  snapshot_id     = samsungcloudplatform_block_storage_snapshot.my_bs_snapshot.id
+
+  virtual_server_id = data.terraform_remote_state.vm.outputs.id
+}
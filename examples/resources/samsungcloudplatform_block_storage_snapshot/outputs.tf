@@ -0,0 +1,3 @@
+output "id" {
+  value = samsungcloudplatform_block_storage_snapshot.my_bs_snapshot.id
+}
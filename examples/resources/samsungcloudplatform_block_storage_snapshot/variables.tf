@@ -0,0 +1,19 @@
+data "terraform_remote_state" "bs" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_block_storage/terraform.tfstate"
+  }
+}
+
+data "terraform_remote_state" "vm" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_virtual_server/terraform.tfstate"
+  }
+}
+
+variable "name" {
+  default = "bssnapshot"
+}
@@ -0,0 +1,11 @@
+data "terraform_remote_state" "bm_bs" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_bm_block_storage/terraform.tfstate"
+  }
+}
+
+resource "samsungcloudplatform_bm_block_storage_snapshot" "bm_bs_snapshot" {
+  bm_block_storage_id = data.terraform_remote_state.bm_bs.outputs.id
+}
@@ -0,0 +1,3 @@
+output "id" {
+  value = samsungcloudplatform_bm_block_storage_snapshot.bm_bs_snapshot.id
+}
@@ -0,0 +1,24 @@
+data "terraform_remote_state" "bm_bs" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_bm_block_storage/terraform.tfstate"
+  }
+}
+
+data "terraform_remote_state" "bm_bs_snapshot" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_bm_block_storage_snapshot/terraform.tfstate"
+  }
+}
+
+resource "samsungcloudplatform_bm_block_storage_snapshot_restore" "bm_bs_restore" {
+  bm_block_storage_id = data.terraform_remote_state.bm_bs.outputs.id
+  snapshot_id         = data.terraform_remote_state.bm_bs_snapshot.outputs.id
+
+  triggers = {
+    restore_request = var.restore_request
+  }
+}
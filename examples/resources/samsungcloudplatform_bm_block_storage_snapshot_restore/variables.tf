@@ -0,0 +1,3 @@
+variable "restore_request" {
+  default = "1"
+}
@@ -21,7 +21,7 @@ resource "samsungcloudplatform_bm_server" "server_001" {
     vpc_id          = data.terraform_remote_state.vpc.outputs.id
     subnet_id       = data.terraform_remote_state.subnet.outputs.id
   delete_protection = false
-  contract_discount = "None"
+  contract          = "None"
   initial_script = ""
 
   servers {
@@ -0,0 +1,25 @@
+data "samsungcloudplatform_region" "region" {
+}
+
+resource "samsungcloudplatform_container_registry" "registry" {
+  registry_name   = var.name
+  service_zone_id = data.samsungcloudplatform_region.region.id
+
+  public_endpoint_enabled = true
+  allowed_ip_addresses    = ["123.123.123.0/24"]
+
+  repository {
+    name                       = "backend"
+    description                = "backend images"
+    keep_last_images_count     = 30
+    delete_untagged_after_days = 7
+  }
+
+  repository {
+    name = "frontend"
+  }
+
+  tags = {
+    tag1 = "value1"
+  }
+}
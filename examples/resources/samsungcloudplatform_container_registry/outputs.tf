@@ -0,0 +1,7 @@
+output "id" {
+  value = samsungcloudplatform_container_registry.registry.id
+}
+
+output "registry_endpoint" {
+  value = samsungcloudplatform_container_registry.registry.registry_endpoint
+}
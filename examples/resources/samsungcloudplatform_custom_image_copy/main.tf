@@ -0,0 +1,7 @@
+# replicate a custom image to another region
+resource "samsungcloudplatform_custom_image_copy" "copy_001" {
+  source_image_id   = var.image_id
+  image_name        = var.name
+  image_description = var.desc
+  region            = var.region
+}
@@ -0,0 +1,3 @@
+output "id" {
+  value = samsungcloudplatform_custom_image_copy.copy_001.id
+}
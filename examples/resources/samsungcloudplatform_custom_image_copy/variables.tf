@@ -0,0 +1,15 @@
+variable "image_id" {
+  default = "IMAGE-XXXXX"
+}
+
+variable "name" {
+  default = "tf_test_image_copy"
+}
+
+variable "desc" {
+  default = "description"
+}
+
+variable "region" {
+  default = "KR-WEST-2"
+}
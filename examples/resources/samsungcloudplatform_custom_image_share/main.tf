@@ -0,0 +1,5 @@
+# share a golden image baked in the tooling project
+resource "samsungcloudplatform_custom_image_share" "share_001" {
+  image_id          = var.image_id
+  target_project_id = var.target_project_id
+}
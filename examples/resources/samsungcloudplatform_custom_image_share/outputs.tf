@@ -0,0 +1,3 @@
+output "id" {
+  value = samsungcloudplatform_custom_image_share.share_001.id
+}
@@ -0,0 +1,7 @@
+variable "image_id" {
+  default = "IMAGE-XXXXX"
+}
+
+variable "target_project_id" {
+  default = "PROJECT-XXXXX"
+}
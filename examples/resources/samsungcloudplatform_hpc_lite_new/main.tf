@@ -17,6 +17,7 @@ resource "samsungcloudplatform_hpc_lite_new" "hpc_lite_new" {
     content {
       server_name = server_details.value.server_name
       ip_address = try(server_details.value.ip_address, null)
+      state = try(server_details.value.state, null)
     }
   }
   tags = {
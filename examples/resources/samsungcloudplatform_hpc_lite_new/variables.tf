@@ -43,6 +43,7 @@ variable "server_details" {
     },
     {
       server_name = "terrahpc02"
+      state       = "STOPPED"
     }
   ]
 }
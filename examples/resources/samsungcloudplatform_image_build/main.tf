@@ -0,0 +1,28 @@
+data "samsungcloudplatform_region" "region" {
+}
+
+data "samsungcloudplatform_standard_image" "ubuntu_image" {
+  region             = data.samsungcloudplatform_region.region.location
+  os_distro          = "Ubuntu"
+  version_constraint = ">= 22.04"
+  most_recent        = true
+}
+
+resource "samsungcloudplatform_image_build" "golden_image" {
+  image_name         = var.name
+  image_description  = var.desc
+  base_image_id      = data.samsungcloudplatform_standard_image.ubuntu_image.id
+  server_type        = var.server_type
+  key_pair_id        = data.terraform_remote_state.key_pair.outputs.id
+  vpc_id             = data.terraform_remote_state.vpc.outputs.id
+  subnet_id          = data.terraform_remote_state.subnet.outputs.id
+  security_group_ids = [data.terraform_remote_state.security_group.outputs.id]
+  nat_enabled        = true
+
+  # the build server is powered off only when the script succeeds, which completes the build
+  build_script = file("${path.module}/provision.sh")
+
+  timeouts {
+    create = "90m"
+  }
+}
@@ -0,0 +1,6 @@
+output "id" {
+  value = samsungcloudplatform_image_build.golden_image.id
+}
+output "build_inputs_hash" {
+  value = samsungcloudplatform_image_build.golden_image.build_inputs_hash
+}
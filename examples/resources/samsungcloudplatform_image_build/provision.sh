@@ -0,0 +1,6 @@
+#!/bin/bash
+set -e
+apt-get update
+apt-get -y upgrade
+apt-get -y install nginx
+cloud-init clean --logs
@@ -1,3 +1,9 @@
 resource "samsungcloudplatform_key_pair" "my_keypair" {
   key_pair_name = var.key-pair-name
 }
+
+# register an existing public key, the private key is not stored in state
+resource "samsungcloudplatform_key_pair" "my_imported_keypair" {
+  key_pair_name = "${var.key-pair-name}-imported"
+  public_key    = file(var.public-key-path)
+}
@@ -1,3 +1,6 @@
 output "id" {
   value = samsungcloudplatform_key_pair.my_keypair.id
 }
+output "imported_fingerprint" {
+  value = samsungcloudplatform_key_pair.my_imported_keypair.fingerprint
+}
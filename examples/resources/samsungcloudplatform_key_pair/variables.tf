@@ -6,3 +6,8 @@ variable "key-pair-name" {
   type = string
   default = "terraform-keypair"
 }
+
+variable "public-key-path" {
+  type = string
+  default = "~/.ssh/id_ed25519.pub"
+}
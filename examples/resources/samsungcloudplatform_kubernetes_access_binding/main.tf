@@ -0,0 +1,10 @@
+resource "samsungcloudplatform_kubernetes_access_binding" "binding" {
+  name      = var.name
+  engine_id = data.terraform_remote_state.engine.outputs.id
+  namespace = data.terraform_remote_state.namespace.outputs.id
+
+  role_kind = "ClusterRole"
+  role_name = "edit"
+
+  iam_group_ids = [data.terraform_remote_state.group.outputs.id]
+}
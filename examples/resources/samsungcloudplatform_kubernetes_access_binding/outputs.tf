@@ -0,0 +1,3 @@
+output "id" {
+  value = samsungcloudplatform_kubernetes_access_binding.binding.id
+}
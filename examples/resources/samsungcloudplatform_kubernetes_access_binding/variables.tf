@@ -0,0 +1,27 @@
+data "terraform_remote_state" "engine" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_kubernetes_engine/terraform.tfstate"
+  }
+}
+
+data "terraform_remote_state" "namespace" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_kubernetes_namespace/terraform.tfstate"
+  }
+}
+
+data "terraform_remote_state" "group" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_iam_group/terraform.tfstate"
+  }
+}
+
+variable "name" {
+  default = "developers-edit"
+}
@@ -25,5 +25,6 @@ resource "samsungcloudplatform_kubernetes_apps" "apps" {
   namespace = data.terraform_remote_state.namespace.outputs.id
   image_id  = data.samsungcloudplatform_kubernetes_apps_image.apps_image.id
   additional_params = var.additional_params
+  wait_for_ready    = true
 }
 
@@ -27,6 +27,31 @@ resource "samsungcloudplatform_kubernetes_engine" "engine" {
   }
   load_balancer_id      = data.terraform_remote_state.load_balancer.outputs.id
   cifs_volume_id    = data.terraform_remote_state.file-storage.outputs.cifs_id
+
+  container_registry_ids = [data.terraform_remote_state.container-registry.outputs.id]
+
+  autoscaler_profile {
+    expander                         = "least-waste"
+    scale_down_delay_after_add       = "10m"
+    scale_down_unneeded_time         = "10m"
+    scale_down_utilization_threshold = 0.5
+  }
+
+  addon {
+    name = "metrics-server"
+  }
+
+  addon {
+    name                 = "ingress-nginx"
+    version              = "1.11.2"
+    configuration_values = jsonencode({ replicaCount = 2 })
+  }
+
+  upgrade_policy {
+    upgrade_node_pools    = true
+    max_surge             = 1
+    drain_timeout_minutes = 30
+  }
 }
 
 
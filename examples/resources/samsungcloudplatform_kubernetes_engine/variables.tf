@@ -30,6 +30,14 @@ data "terraform_remote_state" "file-storage" {
   }
 }
 
+data "terraform_remote_state" "container-registry" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_container_registry/terraform.tfstate"
+  }
+}
+
 data "terraform_remote_state" "load_balancer" {
   backend = "local"
 
@@ -1,4 +1,27 @@
 resource "samsungcloudplatform_kubernetes_namespace" "namespace" {
   name      = var.name
   engine_id = data.terraform_remote_state.engine.outputs.id
+
+  labels = {
+    team = "application"
+  }
+  annotations = {
+    owner = "platform-team"
+  }
+
+  resource_quota {
+    requests_cpu             = "4"
+    requests_memory          = "8Gi"
+    limits_cpu               = "8"
+    limits_memory            = "16Gi"
+    pods                     = 50
+    persistent_volume_claims = 10
+  }
+
+  limit_range {
+    default_cpu            = "500m"
+    default_memory         = "512Mi"
+    default_request_cpu    = "100m"
+    default_request_memory = "128Mi"
+  }
 }
@@ -49,4 +49,12 @@ resource "samsungcloudplatform_kubernetes_node_pool" "pool" {
     key = "test"
     value = "test"
   }
+
+  upgrade_settings {
+    strategy              = "SURGE"
+    max_surge             = 1
+    max_unavailable       = 1
+    drain_timeout_minutes = 30
+    respect_pdb           = true
+  }
 }
@@ -0,0 +1,26 @@
+resource "samsungcloudplatform_launch_template" "my_launch_template" {
+  name            = var.name
+  service_zone_id = var.service_zone_id
+  image_id        = var.image_id
+  key_pair_id     = var.key_pair_id
+  server_type     = var.server_type
+  initial_script  = var.initial_script
+
+  dynamic "block_storages" {
+    for_each = var.block_storages
+    content {
+      block_storage_size = block_storages.value["block_storage_size"]
+      disk_type          = block_storages.value["disk_type"]
+      encryption_enabled = block_storages.value["encryption_enabled"]
+      is_boot_disk       = block_storages.value["is_boot_disk"]
+    }
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+# Auto-Scaling Groups reference a version through its launch configuration ID
+# lc_id = samsungcloudplatform_launch_template.my_launch_template.default_lc_id
+# lc_id = samsungcloudplatform_launch_template.my_launch_template.version_lc_ids["2"]
@@ -0,0 +1,9 @@
+output "id" {
+  value = samsungcloudplatform_launch_template.my_launch_template.id
+}
+output "default_lc_id" {
+  value = samsungcloudplatform_launch_template.my_launch_template.default_lc_id
+}
+output "latest_version" {
+  value = samsungcloudplatform_launch_template.my_launch_template.latest_version
+}
@@ -1,12 +1,12 @@
 
-variable "access_key" {
-  default = ""
+variable "obs_bucket_id" {
+  default = "S3OBJECTSTORAGE-XXXXXXXXX"
 }
-variable "secret_key" {
-  default = ""
+variable "object_key" {
+  default = "images/migration-image.qcow2"
 }
-variable "url" {
-  default = ""
+variable "image_format" {
+  default = "QCOW2"
 }
 variable "name" {
   default = "migration-image"
@@ -0,0 +1,18 @@
+data "terraform_remote_state" "placement_group" {
+  backend = "local"
+
+  config = {
+    path = "../samsungcloudplatform_placement_group/terraform.tfstate"
+  }
+}
+
+data "samsungcloudplatform_virtual_servers" "members" {
+  virtual_server_name = var.virtual_server_name
+}
+
+resource "samsungcloudplatform_placement_group_membership" "my_membership" {
+  for_each = toset([for vs in data.samsungcloudplatform_virtual_servers.members.contents : vs.virtual_server_id])
+
+  placement_group_id = data.terraform_remote_state.placement_group.outputs.id
+  virtual_server_id  = each.value
+}
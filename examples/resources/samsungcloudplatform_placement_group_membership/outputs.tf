@@ -0,0 +1,3 @@
+output "ids" {
+  value = [for m in samsungcloudplatform_placement_group_membership.my_membership : m.id]
+}
@@ -0,0 +1,3 @@
+variable "virtual_server_name" {
+  default = "pg-target-vm-001"
+}
@@ -0,0 +1,8 @@
+data "samsungcloudplatform_region" "my_region" {
+}
+
+resource "samsungcloudplatform_server_group" "my_server_group" {
+  server_group_name    = var.name
+  service_zone_id      = data.samsungcloudplatform_region.my_region.id
+  affinity_policy_type = "ANTI_AFFINITY"
+}
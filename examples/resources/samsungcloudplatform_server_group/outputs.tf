@@ -0,0 +1,3 @@
+output "id" {
+  value = samsungcloudplatform_server_group.my_server_group.id
+}
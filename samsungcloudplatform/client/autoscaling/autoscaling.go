@@ -172,3 +172,111 @@ func (client *Client) GetAutoScalingGroupVirtualServerList(ctx context.Context,
 	}
 	return result, statusCode, err
 }
+
+func (client *Client) CreateAutoScalingGroupSchedule(ctx context.Context, asgId string, request autoscaling2.AsgScheduleCreateRequest) (autoscaling2.AsgScheduleResponse, int, error) {
+	result, c, err := client.sdkClient.AsgScheduleV2Api.CreateAsgScheduleV2(ctx, client.config.ProjectId, asgId, request)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) GetAutoScalingGroupScheduleDetail(ctx context.Context, asgId string, scheduleId string) (autoscaling2.AsgScheduleResponse, int, error) {
+	result, c, err := client.sdkClient.AsgScheduleV2Api.GetAsgScheduleDetailV2(ctx, client.config.ProjectId, asgId, scheduleId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) UpdateAutoScalingGroupSchedule(ctx context.Context, asgId string, scheduleId string, request autoscaling2.AsgScheduleUpdateRequest) (autoscaling2.AsgScheduleResponse, int, error) {
+	result, c, err := client.sdkClient.AsgScheduleV2Api.UpdateAsgScheduleV2(ctx, client.config.ProjectId, asgId, scheduleId, request)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) DeleteAutoScalingGroupSchedule(ctx context.Context, asgId string, scheduleId string) (int, error) {
+	c, err := client.sdkClient.AsgScheduleV2Api.DeleteAsgScheduleV2(ctx, client.config.ProjectId, asgId, scheduleId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return statusCode, err
+}
+
+func (client *Client) CreateAutoScalingGroupLifecycleHook(ctx context.Context, asgId string, request autoscaling2.AsgLifecycleHookCreateRequest) (autoscaling2.AsgLifecycleHookResponse, int, error) {
+	result, c, err := client.sdkClient.AsgLifecycleHookV2Api.CreateAsgLifecycleHookV2(ctx, client.config.ProjectId, asgId, request)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) GetAutoScalingGroupLifecycleHookDetail(ctx context.Context, asgId string, lifecycleHookId string) (autoscaling2.AsgLifecycleHookResponse, int, error) {
+	result, c, err := client.sdkClient.AsgLifecycleHookV2Api.GetAsgLifecycleHookDetailV2(ctx, client.config.ProjectId, asgId, lifecycleHookId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) UpdateAutoScalingGroupLifecycleHook(ctx context.Context, asgId string, lifecycleHookId string, request autoscaling2.AsgLifecycleHookUpdateRequest) (autoscaling2.AsgLifecycleHookResponse, int, error) {
+	result, c, err := client.sdkClient.AsgLifecycleHookV2Api.UpdateAsgLifecycleHookV2(ctx, client.config.ProjectId, asgId, lifecycleHookId, request)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) DeleteAutoScalingGroupLifecycleHook(ctx context.Context, asgId string, lifecycleHookId string) (int, error) {
+	c, err := client.sdkClient.AsgLifecycleHookV2Api.DeleteAsgLifecycleHookV2(ctx, client.config.ProjectId, asgId, lifecycleHookId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return statusCode, err
+}
+
+func (client *Client) CreateAutoScalingGroupNotification(ctx context.Context, asgId string, request autoscaling2.AsgNotificationCreateRequest) (autoscaling2.AsgNotificationResponse, int, error) {
+	result, c, err := client.sdkClient.AsgNotificationV2Api.CreateAsgNotificationV2(ctx, client.config.ProjectId, asgId, request)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) GetAutoScalingGroupNotificationDetail(ctx context.Context, asgId string, notificationId string) (autoscaling2.AsgNotificationResponse, int, error) {
+	result, c, err := client.sdkClient.AsgNotificationV2Api.GetAsgNotificationDetailV2(ctx, client.config.ProjectId, asgId, notificationId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) UpdateAutoScalingGroupNotification(ctx context.Context, asgId string, notificationId string, request autoscaling2.AsgNotificationUpdateRequest) (autoscaling2.AsgNotificationResponse, int, error) {
+	result, c, err := client.sdkClient.AsgNotificationV2Api.UpdateAsgNotificationV2(ctx, client.config.ProjectId, asgId, notificationId, request)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) DeleteAutoScalingGroupNotification(ctx context.Context, asgId string, notificationId string) (int, error) {
+	c, err := client.sdkClient.AsgNotificationV2Api.DeleteAsgNotificationV2(ctx, client.config.ProjectId, asgId, notificationId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return statusCode, err
+}
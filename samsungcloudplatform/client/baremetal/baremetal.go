@@ -119,6 +119,14 @@ func (client *Client) ChangeBMContract(ctx context.Context, serverId string, con
 	return result, err
 }
 
+func (client *Client) ChangeBMNextContract(ctx context.Context, serverId string, nextContractId string) (baremetal.BareMetalServerContractPeriodUpdateResponse, error) {
+	result, _, err := client.sdkClient.BareMetalServerSimpleTaskOpenApiControllerApi.UpdateNextContractPeriod(ctx, client.config.ProjectId, serverId, baremetal.BmServerNextContractPeriodUpdateRequest{
+		NextContractId: nextContractId,
+	})
+
+	return result, err
+}
+
 func (client *Client) ChangeBMDeletePolicy(ctx context.Context, serverId string, deleteProtectionEnabled string) (baremetal.BareMetalServerDetailResponse, error) {
 	result, _, err := client.sdkClient.BareMetalServerSimpleTaskOpenApiControllerApi.UpdateBareMetalServerDeletionProtectionEnabled(ctx, client.config.ProjectId, serverId, baremetal.BmServerDeletionProtectionEnabledUpdateRequest{
 		DeletionProtectionEnabled: deleteProtectionEnabled,
@@ -176,3 +184,14 @@ func (client *Client) StartBareMetalServer(ctx context.Context, request BMStartS
 
 	return result, err
 }
+
+func (client *Client) ReinstallBareMetalServerOs(ctx context.Context, serverId string, request BMOsReinstallRequest) (baremetal.AsyncResponse, error) {
+	result, _, err := client.sdkClient.BareMetalServerLongRunningTaskOpenApiV2ControllerApi.ReinstallBareMetalServerOsV2(ctx, client.config.ProjectId, serverId, baremetal.BareMetalServerOsReinstallRequest{
+		ImageId:        request.ImageId,
+		InitScript:     request.InitScript,
+		OsUserId:       request.OsUserId,
+		OsUserPassword: request.OsUserPassword,
+	})
+
+	return result, err
+}
@@ -76,3 +76,14 @@ type TagRequest struct {
 type BMStartStopRequest struct {
 	BareMetalServerIds []string
 }
+
+type BMOsReinstallRequest struct {
+	// Image ID Image ID is obtained through @[List Standard Images]
+	ImageId string
+	// Initial Script Content
+	InitScript string
+	// OS User Id
+	OsUserId string
+	// OS User Password
+	OsUserPassword string
+}
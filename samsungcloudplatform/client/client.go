@@ -8,6 +8,7 @@ import (
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/baremetalvdc"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/certificate"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/configinspection"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/containerregistry"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/database/epas"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/database/kafka"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/database/mariadb"
@@ -78,9 +79,10 @@ type SCPClient struct {
 	Gslb            *gslb.Client
 
 	// Kubernetes
-	Kubernetes       *kubernetes.Client
-	KubernetesEngine *kubernetesengine.Client
-	KubernetesApps   *kubernetesapps.Client
+	Kubernetes        *kubernetes.Client
+	KubernetesEngine  *kubernetesengine.Client
+	KubernetesApps    *kubernetesapps.Client
+	ContainerRegistry *containerregistry.Client
 
 	// Compute
 	Image          *image.Client
@@ -211,9 +213,10 @@ func NewSCPClient(providerConfig *Config) (*SCPClient, error) {
 		Gslb:            gslb.NewClient(NewDefaultConfig(providerConfig, "oss2")),
 
 		// Kubernetes
-		Kubernetes:       kubernetes.NewClient(NewDefaultConfig(providerConfig, "kubernetes")),
-		KubernetesEngine: kubernetesengine.NewClient(NewDefaultConfig(providerConfig, "kubernetes-engine2")),
-		KubernetesApps:   kubernetesapps.NewClient(NewDefaultConfig(providerConfig, "kubernetes-apps")),
+		Kubernetes:        kubernetes.NewClient(NewDefaultConfig(providerConfig, "kubernetes")),
+		KubernetesEngine:  kubernetesengine.NewClient(NewDefaultConfig(providerConfig, "kubernetes-engine2")),
+		KubernetesApps:    kubernetesapps.NewClient(NewDefaultConfig(providerConfig, "kubernetes-apps")),
+		ContainerRegistry: containerregistry.NewClient(NewDefaultConfig(providerConfig, "container-registry")),
 
 		// Compute
 		Image:          image.NewClient(NewDefaultConfig(providerConfig, "oss2")),
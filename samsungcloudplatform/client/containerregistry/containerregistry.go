@@ -0,0 +1,105 @@
+package containerregistry
+
+import (
+	"context"
+
+	sdk "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/client"
+	containerregistry2 "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/container-registry2"
+	"github.com/antihax/optional"
+)
+
+type Client struct {
+	config    *sdk.Configuration
+	sdkClient *containerregistry2.APIClient
+}
+
+func NewClient(config *sdk.Configuration) *Client {
+	return &Client{
+		config:    config,
+		sdkClient: containerregistry2.NewAPIClient(config),
+	}
+}
+
+func (client *Client) CreateRegistry(ctx context.Context, request CreateRegistryRequest) (containerregistry2.AsyncResponse, error) {
+	result, _, err := client.sdkClient.RegistryV1Api.CreateRegistryV1(ctx, client.config.ProjectId, containerregistry2.RegistryCreateV1Request{
+		RegistryName:          request.RegistryName,
+		ServiceZoneId:         request.ServiceZoneId,
+		PublicEndpointEnabled: &request.PublicEndpointEnabled,
+		AllowedIpAddresses:    request.AllowedIpAddresses,
+		Tags:                  client.sdkClient.ToTagRequestList(request.Tags),
+	})
+	return result, err
+}
+
+func (client *Client) GetRegistry(ctx context.Context, registryId string) (containerregistry2.RegistryDetailV1Response, int, error) {
+	result, c, err := client.sdkClient.RegistryV1Api.DetailRegistryV1(ctx, client.config.ProjectId, registryId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) GetRegistryList(ctx context.Context, registryName string) (containerregistry2.ListResponseRegistryV1Response, error) {
+	var optRegistryName optional.String
+	if len(registryName) > 0 {
+		optRegistryName = optional.NewString(registryName)
+	}
+
+	result, _, err := client.sdkClient.RegistryV1Api.ListRegistriesV1(ctx, client.config.ProjectId, &containerregistry2.RegistryV1ApiListRegistriesV1Opts{
+		RegistryName: optRegistryName,
+		Page:         optional.NewInt32(0),
+		Size:         optional.NewInt32(10000),
+	})
+	return result, err
+}
+
+func (client *Client) UpdateRegistryAccessControl(ctx context.Context, registryId string, request UpdateRegistryAccessControlRequest) (containerregistry2.AsyncResponse, error) {
+	result, _, err := client.sdkClient.RegistryV1Api.UpdateRegistryAccessControlV1(ctx, client.config.ProjectId, registryId, containerregistry2.RegistryAccessControlUpdateV1Request{
+		PublicEndpointEnabled: &request.PublicEndpointEnabled,
+		AllowedIpAddresses:    request.AllowedIpAddresses,
+	})
+	return result, err
+}
+
+func (client *Client) DeleteRegistry(ctx context.Context, registryId string) (int, error) {
+	c, err := client.sdkClient.RegistryV1Api.DeleteRegistryV1(ctx, client.config.ProjectId, registryId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return statusCode, err
+}
+
+func (client *Client) CreateRepository(ctx context.Context, registryId string, request CreateRepositoryRequest) (containerregistry2.RepositoryV1Response, error) {
+	result, _, err := client.sdkClient.RepositoryV1Api.CreateRepositoryV1(ctx, client.config.ProjectId, registryId, containerregistry2.RepositoryCreateV1Request{
+		RepositoryName: request.RepositoryName,
+		Description:    request.Description,
+	})
+	return result, err
+}
+
+func (client *Client) GetRepositoryList(ctx context.Context, registryId string) (containerregistry2.ListResponseRepositoryV1Response, error) {
+	result, _, err := client.sdkClient.RepositoryV1Api.ListRepositoriesV1(ctx, client.config.ProjectId, registryId, &containerregistry2.RepositoryV1ApiListRepositoriesV1Opts{
+		Page: optional.NewInt32(0),
+		Size: optional.NewInt32(10000),
+	})
+	return result, err
+}
+
+func (client *Client) UpdateRetentionPolicy(ctx context.Context, registryId string, repositoryName string, request UpdateRetentionPolicyRequest) (containerregistry2.RepositoryV1Response, error) {
+	result, _, err := client.sdkClient.RepositoryV1Api.UpdateRepositoryRetentionPolicyV1(ctx, client.config.ProjectId, registryId, repositoryName, containerregistry2.RepositoryRetentionPolicyUpdateV1Request{
+		KeepLastImagesCount:     request.KeepLastImagesCount,
+		DeleteUntaggedAfterDays: request.DeleteUntaggedAfterDays,
+	})
+	return result, err
+}
+
+func (client *Client) DeleteRepository(ctx context.Context, registryId string, repositoryName string) (int, error) {
+	c, err := client.sdkClient.RepositoryV1Api.DeleteRepositoryV1(ctx, client.config.ProjectId, registryId, repositoryName)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return statusCode, err
+}
@@ -0,0 +1,24 @@
+package containerregistry
+
+type CreateRegistryRequest struct {
+	RegistryName          string
+	ServiceZoneId         string
+	PublicEndpointEnabled bool
+	AllowedIpAddresses    []string
+	Tags                  map[string]interface{}
+}
+
+type UpdateRegistryAccessControlRequest struct {
+	PublicEndpointEnabled bool
+	AllowedIpAddresses    []string
+}
+
+type CreateRepositoryRequest struct {
+	RepositoryName string
+	Description    string
+}
+
+type UpdateRetentionPolicyRequest struct {
+	KeepLastImagesCount     int32
+	DeleteUntaggedAfterDays int32
+}
@@ -22,10 +22,10 @@ func NewClient(config *sdk.Configuration) *Client {
 func (client *Client) GetHpcLiteNewDetail(ctx context.Context, serverId string) (hpclitenew.HpcLitePlusOpenApiDetailResponseDto, int, error) {
 	responseVo, httpResponse, err := client.sdkClient.HpcLitePlusOpenAPIV1ControllerApi.DetailHpcLitePlusV1(ctx, client.config.ProjectId, serverId)
 	if err != nil {
-		return *new(hpclitenew.HpcLitePlusOpenApiDetailResponseDto), httpResponse.StatusCode, err
+		return *new(hpclitenew.HpcLitePlusOpenApiDetailResponseDto), getStatusCode(httpResponse), err
 	}
 
-	return responseVo, httpResponse.StatusCode, err
+	return responseVo, getStatusCode(httpResponse), err
 }
 
 func (client *Client) CreateHpcLiteNew(ctx context.Context, request HpcLiteNewCreateRequest) (hpclitenew.AsyncListResponse, int, error) {
@@ -63,6 +63,55 @@ func (client *Client) DeleteHpcLiteNew(ctx context.Context, request HpcLiteNewDe
 	return result, statusCode, err
 }
 
+func (client *Client) StartHpcLiteNew(ctx context.Context, request HpcLiteNewStartStopRequest) (hpclitenew.AsyncListResponse, int, error) {
+	result, c, err := client.sdkClient.HpcLitePlusOpenAPIV1ControllerApi.StartHpcLitePlusV1(ctx, client.config.ProjectId, hpclitenew.HpcLitePlusOpenApiStartStopRequestVo{
+		ServerIds:     request.ServerIds,
+		ServiceZoneId: request.ServiceZoneId,
+	})
+
+	statusCode := getStatusCode(c)
+	return result, statusCode, err
+}
+
+func (client *Client) StopHpcLiteNew(ctx context.Context, request HpcLiteNewStartStopRequest) (hpclitenew.AsyncListResponse, int, error) {
+	result, c, err := client.sdkClient.HpcLitePlusOpenAPIV1ControllerApi.StopHpcLitePlusV1(ctx, client.config.ProjectId, hpclitenew.HpcLitePlusOpenApiStartStopRequestVo{
+		ServerIds:     request.ServerIds,
+		ServiceZoneId: request.ServiceZoneId,
+	})
+
+	statusCode := getStatusCode(c)
+	return result, statusCode, err
+}
+
+func (client *Client) UpdateHpcLiteNewContract(ctx context.Context, request HpcLiteNewContractUpdateRequest) (hpclitenew.AsyncListResponse, int, error) {
+	result, c, err := client.sdkClient.HpcLitePlusOpenAPIV1ControllerApi.UpdateHpcLitePlusContractV1(ctx, client.config.ProjectId, hpclitenew.HpcLitePlusOpenApiContractUpdateRequestVo{
+		Contract:      request.Contract,
+		ServerIds:     request.ServerIds,
+		ServiceZoneId: request.ServiceZoneId,
+	})
+
+	statusCode := getStatusCode(c)
+	return result, statusCode, err
+}
+
+func (client *Client) UpdateHpcLiteNewNextContract(ctx context.Context, request HpcLiteNewContractUpdateRequest) (hpclitenew.AsyncListResponse, int, error) {
+	result, c, err := client.sdkClient.HpcLitePlusOpenAPIV1ControllerApi.UpdateHpcLitePlusNextContractV1(ctx, client.config.ProjectId, hpclitenew.HpcLitePlusOpenApiNextContractUpdateRequestVo{
+		NextContract:  request.Contract,
+		ServerIds:     request.ServerIds,
+		ServiceZoneId: request.ServiceZoneId,
+	})
+
+	statusCode := getStatusCode(c)
+	return result, statusCode, err
+}
+
+func (client *Client) GetHpcLiteNewResourcePoolList(ctx context.Context, serviceZoneId string) (hpclitenew.ListResponseHpcLitePlusResourcePoolResponseDto, int, error) {
+	result, c, err := client.sdkClient.HpcLitePlusOpenAPIV1ControllerApi.ListHpcLitePlusResourcePoolsV1(ctx, client.config.ProjectId, serviceZoneId)
+
+	statusCode := getStatusCode(c)
+	return result, statusCode, err
+}
+
 func toServerDetailsVoList(serverDetailList []ServerDetailRequest) []hpclitenew.ServerDetailRequestVo {
 	var ret = []hpclitenew.ServerDetailRequestVo{}
 	for _, v := range serverDetailList {
@@ -40,3 +40,15 @@ type HpcLiteNewDeleteRequest struct {
 	ServerIds     []string
 	ServiceZoneId string
 }
+
+type HpcLiteNewStartStopRequest struct {
+	ServerIds     []string
+	ServiceZoneId string
+}
+
+type HpcLiteNewContractUpdateRequest struct {
+	// HPC Lite(New) Contract
+	Contract      string
+	ServerIds     []string
+	ServiceZoneId string
+}
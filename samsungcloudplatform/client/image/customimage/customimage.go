@@ -56,3 +56,42 @@ func (client *Client) DeleteCustomImage(ctx context.Context, imageId string) err
 	_, _, err := client.sdkClient.CustomImageV2Api.DeleteCustomImage(ctx, client.config.ProjectId, imageId)
 	return err
 }
+
+func (client *Client) CopyCustomImage(ctx context.Context, imageId string, request image.CustomImageCopyRequest, tags map[string]interface{}) (image.AsyncResponse, int, error) {
+	request.Tags = client.sdkClient.ToTagRequestList(tags)
+	result, c, err := client.sdkClient.CustomImageV2Api.CopyCustomImage(ctx, client.config.ProjectId, imageId, request)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) CreateCustomImageShare(ctx context.Context, imageId string, targetProjectId string) (image.CustomImageShareResponse, int, error) {
+	result, c, err := client.sdkClient.CustomImageShareV2Api.CreateCustomImageShare(ctx, client.config.ProjectId, imageId, image.CustomImageShareCreateRequest{
+		TargetProjectId: targetProjectId,
+	})
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) GetCustomImageShareList(ctx context.Context, imageId string) (image.ListResponseCustomImageShareResponse, int, error) {
+	result, c, err := client.sdkClient.CustomImageShareV2Api.ListCustomImageShares(ctx, client.config.ProjectId, imageId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) DeleteCustomImageShare(ctx context.Context, imageId string, shareId string) (int, error) {
+	c, err := client.sdkClient.CustomImageShareV2Api.DeleteCustomImageShare(ctx, client.config.ProjectId, imageId, shareId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return statusCode, err
+}
@@ -34,6 +34,11 @@ func (client *Client) GetMigrationImageInfo(ctx context.Context, migrationImageI
 
 func (client *Client) CreateMigrationImage(ctx context.Context, request image2.MigrationImageCreateRequest, tags map[string]interface{}) (image2.AsyncResponse, error) {
 	request.Tags = client.sdkClient.ToTagRequestList(tags)
+	// 별도의 인증키가 없으면 provider 인증키로 object storage 에 접근
+	if len(request.AccessKey) == 0 && client.config.Credentials != nil {
+		request.AccessKey = client.config.Credentials.AccessKey
+		request.SecretKey = client.config.Credentials.SecretKey
+	}
 	result, _, err := client.sdkClient.MigrationImageV2Api.CreateMigrationImage(ctx, client.config.ProjectId, request)
 	return result, err
 }
@@ -28,6 +28,16 @@ func (client *Client) CreateKeyPair(ctx context.Context, request CreateRequest)
 	return result, err
 }
 
+func (client *Client) ImportKeyPair(ctx context.Context, request CreateRequest) (keypair.KeyPairV1Response, error) {
+	result, _, err := client.sdkClient.KeyPairV1Api.ImportKeyPair(ctx, client.config.ProjectId, keypair.KeyPairImportV1Request{
+		KeyPairName: request.KeyPairName,
+		PublicKey:   request.PublicKey,
+		Tags:        client.sdkClient.ToTagRequestList(request.Tags),
+	})
+
+	return result, err
+}
+
 func (client *Client) DetailKeyPair(ctx context.Context, keyPairId string) (keypair.KeyPairV1Response, error) {
 	result, _, err := client.sdkClient.KeyPairV1Api.DetailKeyPair(ctx, client.config.ProjectId, keyPairId)
 	return result, err
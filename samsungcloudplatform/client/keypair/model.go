@@ -2,6 +2,7 @@ package keypair
 
 type CreateRequest struct {
 	KeyPairName string
+	PublicKey   string
 	Tags        map[string]interface{}
 }
 
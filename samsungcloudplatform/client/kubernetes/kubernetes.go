@@ -4,6 +4,7 @@ import (
 	"context"
 	sdk "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/client"
 	"github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/kubernetes"
+	"github.com/antihax/optional"
 )
 
 type Client struct {
@@ -30,6 +31,54 @@ func (client *Client) CreateNamespace(ctx context.Context, clusterId string, nam
 	return result, statusCode, err
 }
 
+func (client *Client) CreateObject(ctx context.Context, clusterId string, yaml string) (kubernetes.K8sObjectResponse, int, error) {
+	result, response, err := client.sdk.K8sObjectYamlServiceApi.CreateK8sObjectV2(ctx, client.config.ProjectId, clusterId, kubernetes.K8sObjectCreateRequest{
+		Yaml: yaml,
+	})
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) ReadObject(ctx context.Context, clusterId string, kind string, namespace string, name string) (string, int, error) {
+	result, response, err := client.sdk.K8sObjectYamlServiceApi.DetailK8sObjectYamlV2(ctx, client.config.ProjectId, clusterId, kind, name, &kubernetes.K8sObjectYamlServiceApiDetailK8sObjectYamlV2Opts{
+		Namespace: optional.NewString(namespace),
+	})
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result.Yaml, statusCode, err
+}
+
+func (client *Client) UpdateObject(ctx context.Context, clusterId string, yaml string) (kubernetes.K8sObjectResponse, int, error) {
+	result, response, err := client.sdk.K8sObjectYamlServiceApi.UpdateK8sObjectV2(ctx, client.config.ProjectId, clusterId, kubernetes.K8sObjectUpdateRequest{
+		Yaml: yaml,
+	})
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) DeleteObject(ctx context.Context, clusterId string, yaml string) (int, error) {
+	response, err := client.sdk.K8sObjectYamlServiceApi.DeleteK8sObjectV2(ctx, client.config.ProjectId, clusterId, kubernetes.K8sObjectYamlDeleteRequest{
+		Yaml: yaml,
+	})
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return statusCode, err
+}
+
 func (client *Client) ReadNamespace(ctx context.Context, clusterId string, name string) (kubernetes.NamespaceResponse, int, error) {
 	result, response, err := client.sdk.NamespaceServiceApi.DetailNamespaceV2(ctx, client.config.ProjectId, clusterId, name)
 	var statusCode int
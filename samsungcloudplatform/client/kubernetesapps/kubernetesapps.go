@@ -47,6 +47,28 @@ func (client *Client) ReadApps(ctx context.Context, id string) (kubernetesapps.K
 	return result, statusCode, err
 }
 
+func (client *Client) UpgradeApps(ctx context.Context, clusterId string, namespace string, id string, imageId string, productGroupId string, additionalParams map[string]interface{}) (kubernetesapps.K8sAppsResponse, int, error) {
+	result, response, err := client.sdk.ReleaseApi.UpgradeReleaseV1(ctx, client.config.ProjectId, clusterId, namespace, id, kubernetesapps.ReleaseUpgradeRequest{
+		ImageId:          imageId,
+		ProductGroupId:   productGroupId,
+		AdditionalParams: additionalParams,
+	})
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) ListAppsObjects(ctx context.Context, id string) ([]kubernetesapps.K8sAppsObjectResponse, int, error) {
+	result, response, err := client.sdk.K8sAppsApi.ListK8sAppsObjectsV1(ctx, client.config.ProjectId, id)
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result.Contents, statusCode, err
+}
+
 func (client *Client) DeleteApps(ctx context.Context, clusterId string, namespace string, id string) (int, error) {
 	response, err := client.sdk.ReleaseApi.DeleteReleaseV1(ctx, client.config.ProjectId, clusterId, namespace, id)
 	var statusCode int
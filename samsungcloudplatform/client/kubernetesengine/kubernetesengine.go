@@ -89,6 +89,120 @@ func (client *Client) UpgradeEngine(ctx context.Context, id string, request Upgr
 	return result, statusCode, err
 }
 
+func (client *Client) GetEngineAutoscalerProfile(ctx context.Context, id string) (kubernetesengine2.ClusterAutoscalerProfileV2Response, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.DetailKubernetesEngineAutoscalerProfileV2(ctx, client.config.ProjectId, id)
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) UpdateEngineAutoscalerProfile(ctx context.Context, id string, request UpdateEngineAutoscalerProfileRequest) (kubernetesengine2.AsyncResponse, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.UpdateKubernetesEngineAutoscalerProfileV2(ctx, client.config.ProjectId, id, kubernetesengine2.ClusterAutoscalerProfileUpdateV2Request{
+		Expander:                      request.Expander,
+		ScaleDownDelayAfterAdd:        request.ScaleDownDelayAfterAdd,
+		ScaleDownUnneededTime:         request.ScaleDownUnneededTime,
+		ScaleDownUtilizationThreshold: request.ScaleDownUtilizationThreshold,
+	})
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) GetEngineRegistryList(ctx context.Context, id string) (kubernetesengine2.ListResponseClusterRegistryV2Response, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.ListKubernetesEngineRegistriesV2(ctx, client.config.ProjectId, id)
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) AttachEngineRegistry(ctx context.Context, id string, registryId string) (kubernetesengine2.AsyncResponse, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.AttachKubernetesEngineRegistryV2(ctx, client.config.ProjectId, id, kubernetesengine2.ClusterRegistryAttachV2Request{
+		RegistryId: registryId,
+	})
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) DetachEngineRegistry(ctx context.Context, id string, registryId string) (kubernetesengine2.AsyncResponse, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.DetachKubernetesEngineRegistryV2(ctx, client.config.ProjectId, id, registryId)
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) GetEngineAddonList(ctx context.Context, id string) (kubernetesengine2.ListResponseClusterAddonV2Response, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.ListKubernetesEngineAddonsV2(ctx, client.config.ProjectId, id)
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) GetAddonVersionList(ctx context.Context, k8sVersion string) (kubernetesengine2.ListResponseAddonVersionV2Response, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.ListKubernetesAddonVersionsV2(ctx, client.config.ProjectId, k8sVersion)
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) InstallEngineAddon(ctx context.Context, id string, request InstallEngineAddonRequest) (kubernetesengine2.AsyncResponse, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.InstallKubernetesEngineAddonV2(ctx, client.config.ProjectId, id, kubernetesengine2.ClusterAddonInstallV2Request{
+		AddonName:           request.AddonName,
+		AddonVersion:        request.AddonVersion,
+		ConfigurationValues: request.ConfigurationValues,
+	})
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) UpdateEngineAddon(ctx context.Context, id string, addonName string, request UpdateEngineAddonRequest) (kubernetesengine2.AsyncResponse, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.UpdateKubernetesEngineAddonV2(ctx, client.config.ProjectId, id, addonName, kubernetesengine2.ClusterAddonUpdateV2Request{
+		AddonVersion:        request.AddonVersion,
+		ConfigurationValues: request.ConfigurationValues,
+	})
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) UninstallEngineAddon(ctx context.Context, id string, addonName string) (kubernetesengine2.AsyncResponse, int, error) {
+	result, response, err := client.sdk.K8sEngineV2Api.UninstallKubernetesEngineAddonV2(ctx, client.config.ProjectId, id, addonName)
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
 func (client *Client) UpdateLoggingEngine(ctx context.Context, id string, request UpdateEngineLoggingRequest) (kubernetesengine2.AsyncResponse, int, error) {
 	result, response, err := client.sdk.K8sEngineV2Api.UpdateKubernetesEngineLoggingV2(ctx, client.config.ProjectId, id, kubernetesengine2.ClusterCloudLoggingUpdateV2Request{
 		CloudLoggingEnabled: &request.CloudLoggingEnabled,
@@ -305,7 +419,22 @@ func (client *Client) UpdateNodePool(ctx context.Context, engineId string, nodeP
 
 func (client *Client) UpgradeNodePool(ctx context.Context, engineId string, nodePoolId string, request NodePoolUgradeRequest) (kubernetesengine2.AsyncResponse, int, error) {
 	result, response, err := client.sdk.NodePoolV3Api.UpgradeNodePoolV3(ctx, client.config.ProjectId, engineId, nodePoolId, kubernetesengine2.NodePoolUpgradeV3Request{
-		UpgradeImageId: request.UpgradeImageId,
+		UpgradeImageId:      request.UpgradeImageId,
+		DrainTimeoutMinutes: request.DrainTimeoutMinutes,
+		MaxUnavailable:      request.MaxUnavailable,
+		RespectPdb:          &request.RespectPdb,
+	})
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) DrainNodePool(ctx context.Context, engineId string, nodePoolId string, request NodePoolDrainRequest) (kubernetesengine2.AsyncResponse, int, error) {
+	result, response, err := client.sdk.NodePoolV2Api.DrainNodePoolV2(ctx, client.config.ProjectId, engineId, nodePoolId, kubernetesengine2.NodePoolDrainV2Request{
+		DrainTimeoutMinutes: request.DrainTimeoutMinutes,
+		RespectPdb:          &request.RespectPdb,
 	})
 	var statusCode int
 	if response != nil {
@@ -375,3 +504,13 @@ func (client *Client) GetNodePoolList(ctx context.Context, kubernetesEngineId st
 	}
 	return result, statusCode, err
 }
+
+func (client *Client) GetNodeList(ctx context.Context, kubernetesEngineId string, request *kubernetesengine2.NodeV2ApiListNodesV2Opts) (kubernetesengine2.PageResponseNodeV2Response, int, error) {
+	result, response, err := client.sdk.NodeV2Api.ListNodesV2(ctx, client.config.ProjectId, kubernetesEngineId, request)
+
+	var statusCode int
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	return result, statusCode, err
+}
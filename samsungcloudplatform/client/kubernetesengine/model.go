@@ -35,6 +35,24 @@ type UpgradeRequest struct {
 	K8sVersion string
 }
 
+type UpdateEngineAutoscalerProfileRequest struct {
+	Expander                      string
+	ScaleDownDelayAfterAdd        string
+	ScaleDownUnneededTime         string
+	ScaleDownUtilizationThreshold float64
+}
+
+type InstallEngineAddonRequest struct {
+	AddonName           string
+	AddonVersion        string
+	ConfigurationValues string
+}
+
+type UpdateEngineAddonRequest struct {
+	AddonVersion        string
+	ConfigurationValues string
+}
+
 type UpdateEngineLoggingRequest struct {
 	CloudLoggingEnabled bool
 }
@@ -89,7 +107,15 @@ type NodePoolUpdateRequest struct {
 }
 
 type NodePoolUgradeRequest struct {
-	UpgradeImageId string
+	UpgradeImageId      string
+	DrainTimeoutMinutes int32
+	MaxUnavailable      int32
+	RespectPdb          bool
+}
+
+type NodePoolDrainRequest struct {
+	DrainTimeoutMinutes int32
+	RespectPdb          bool
 }
 
 type UpdateNodePoolLabelsRequest struct {
@@ -12,6 +12,10 @@ type CreateRequest struct {
 	VirtualServerType string
 	// Placement Group description
 	PlacementGroupDescription string
+	// Placement strategy (SPREAD, PARTITION)
+	PlacementStrategy string
+	// Number of partitions for PARTITION strategy
+	PartitionCount int32
 }
 
 type ListPlacementGroupsRequestParam struct {
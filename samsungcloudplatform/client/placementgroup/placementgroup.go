@@ -27,6 +27,8 @@ func (client *Client) CreatePlacementGroup(ctx context.Context, request CreateRe
 		VirtualServerType:         request.VirtualServerType,
 		PlacementGroupDescription: request.PlacementGroupDescription,
 		AvailabilityZoneName:      request.AvailabilityZoneName,
+		PlacementStrategy:         request.PlacementStrategy,
+		PartitionCount:            request.PartitionCount,
 	})
 	return result, err
 }
@@ -0,0 +1,13 @@
+package servergroup
+
+type CreateServerGroupRequest struct {
+	// Affinity policy type (ANTI_AFFINITY, AFFINITY)
+	AffinityPolicyType string
+	DeploymentEnvType  string
+	ServerGroupName    string
+	ServerGroupType    string
+	ServiceZoneId      string
+	// Service that uses the server group (e.g. Virtual Server)
+	ServicedFor      string
+	ServicedGroupFor string
+}
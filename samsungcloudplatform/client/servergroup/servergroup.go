@@ -19,17 +19,36 @@ func NewClient(config *sdk.Configuration) *Client {
 	}
 }
 
-//func (client *Client) CreateServerGroup(ctx context.Context, affinityPolicyType string,) {
-//	client.sdkClient.ServerGroupOperationControllerApi.CreateServerGroup(ctx, client.config.ProjectId, servergroup2.ServerGroupCreateRequest{
-//		AffinityPolicyType: "",
-//		DeploymentEnvType:  "",
-//		ServerGroupName:    "",
-//		ServerGroupType:    "",
-//		ServiceZoneId:      "",
-//		ServicedFor:        "",
-//		ServicedGroupFor:   "",
-//	})
-//}
+func (client *Client) CreateServerGroup(ctx context.Context, request CreateServerGroupRequest) (servergroup2.ServerGroupResponse, error) {
+	result, _, err := client.sdkClient.ServerGroupOperationControllerApi.CreateServerGroup(ctx, client.config.ProjectId, servergroup2.ServerGroupCreateRequest{
+		AffinityPolicyType: request.AffinityPolicyType,
+		DeploymentEnvType:  request.DeploymentEnvType,
+		ServerGroupName:    request.ServerGroupName,
+		ServerGroupType:    request.ServerGroupType,
+		ServiceZoneId:      request.ServiceZoneId,
+		ServicedFor:        request.ServicedFor,
+		ServicedGroupFor:   request.ServicedGroupFor,
+	})
+	return result, err
+}
+
+func (client *Client) GetServerGroupDetail(ctx context.Context, serverGroupId string) (servergroup2.ServerGroupResponse, int, error) {
+	result, c, err := client.sdkClient.ServerGroupSearchControllerApi.DetailServerGroup(ctx, client.config.ProjectId, serverGroupId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) DeleteServerGroup(ctx context.Context, serverGroupId string) (int, error) {
+	c, err := client.sdkClient.ServerGroupOperationControllerApi.DeleteServerGroup(ctx, client.config.ProjectId, serverGroupId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return statusCode, err
+}
 
 func (client *Client) GetServerGroup(ctx context.Context, serverGroupName string, servicedFor []string) (servergroup2.ListResponseServerGroupsResponse, error) {
 	var optServerGroupName optional.String
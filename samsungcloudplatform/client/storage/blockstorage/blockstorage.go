@@ -28,6 +28,7 @@ func (client *Client) CreateBlockStorage(ctx context.Context, request CreateBloc
 		DiskType:         request.DiskType,
 		SharedType:       request.SharedType,
 		VirtualServerId:  request.VirtualServerId,
+		SnapshotId:       request.SnapshotId,
 		Tags:             client.sdkClient.ToTagRequestList(tags),
 	}
 
@@ -118,3 +119,34 @@ func (client *Client) ListBlockStorageVirtualServers(ctx context.Context, blockS
 	})
 	return result, err
 }
+
+func (client *Client) CreateBlockStorageSnapshot(ctx context.Context, blockStorageId string, request CreateBlockStorageSnapshotRequest) (blockstorage2.AsyncResponse, error) {
+	result, _, err := client.sdkClient.BlockStorageSnapshotControllerApi.CreateBlockStorageSnapshot(ctx, client.config.ProjectId, blockStorageId, blockstorage2.BlockStorageSnapshotCreateRequest{
+		SnapshotName:        request.SnapshotName,
+		SnapshotDescription: request.SnapshotDescription,
+	})
+	return result, err
+}
+
+func (client *Client) ReadBlockStorageSnapshot(ctx context.Context, blockStorageId string, snapshotId string) (blockstorage2.BlockStorageSnapshotResponse, int, error) {
+	result, c, err := client.sdkClient.BlockStorageSnapshotControllerApi.DetailBlockStorageSnapshot(ctx, client.config.ProjectId, blockStorageId, snapshotId)
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) ReadBlockStorageSnapshotList(ctx context.Context, blockStorageId string, request ReadBlockStorageSnapshotRequest) (blockstorage2.ListResponseBlockStorageSnapshotResponse, error) {
+	result, _, err := client.sdkClient.BlockStorageSnapshotControllerApi.ListBlockStorageSnapshots(ctx, client.config.ProjectId, blockStorageId, &blockstorage2.BlockStorageSnapshotControllerApiListBlockStorageSnapshotsOpts{
+		SnapshotName: optional.NewString(request.SnapshotName),
+		Page:         optional.NewInt32(request.Page),
+		Size:         optional.NewInt32(request.Size),
+	})
+	return result, err
+}
+
+func (client *Client) DeleteBlockStorageSnapshot(ctx context.Context, blockStorageId string, snapshotId string) (blockstorage2.AsyncResponse, error) {
+	result, _, err := client.sdkClient.BlockStorageSnapshotControllerApi.DeleteBlockStorageSnapshot(ctx, client.config.ProjectId, blockStorageId, snapshotId)
+	return result, err
+}
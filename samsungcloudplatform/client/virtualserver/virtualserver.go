@@ -313,3 +313,25 @@ func (client *Client) DeleteRole(ctx context.Context, virtualServerId string) (v
 	}
 	return result, statusCode, err
 }
+
+func (client *Client) UpdateContract(ctx context.Context, virtualServerId string, contractId string) (virtualserver2.DetailVirtualServerV3Response, int, error) {
+	result, c, err := client.sdkClient.VirtualServerV3Api.UpdateVirtualServerContract1(ctx, client.config.ProjectId, virtualServerId, virtualserver2.VirtualServerContractUpdateRequest{
+		ContractId: contractId,
+	})
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
+
+func (client *Client) UpdateNextContract(ctx context.Context, virtualServerId string, nextContractId string) (virtualserver2.DetailVirtualServerV3Response, int, error) {
+	result, c, err := client.sdkClient.VirtualServerV3Api.UpdateVirtualServerNextContract1(ctx, client.config.ProjectId, virtualServerId, virtualserver2.VirtualServerNextContractUpdateRequest{
+		NextContractId: nextContractId,
+	})
+	var statusCode int
+	if c != nil {
+		statusCode = c.StatusCode
+	}
+	return result, statusCode, err
+}
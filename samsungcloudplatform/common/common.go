@@ -54,17 +54,19 @@ const (
 	VpcPublicIpPurpose            string = "NAT"
 	VpcPublicIpNetworkServiceType string = "VPC"
 
-	ServicedGroupCompute       string = "COMPUTE"
-	ServicedForVirtualServer   string = "Virtual Server"
-	ServicedForGpuServer       string = "GPU Server"
-	ServicedForBaremetalServer string = "Baremetal Server"
-	ServicedGroupDatabase      string = "DATABASE"
-	ServicedForPostgresql      string = "PostgreSQL"
-	ServicedForMariadb         string = "Mariadb"
-	ServicedForMySql           string = "MySql"
-	ServicedForEpas            string = "EPAS"
-	ServicedForSqlServer       string = "Microsoft SQL Server"
-	ServicedForTibero          string = "Tibero"
+	ServicedGroupCompute          string = "COMPUTE"
+	ServicedForVirtualServer      string = "Virtual Server"
+	ServicedForGpuServer          string = "GPU Server"
+	ServicedForBaremetalServer    string = "Baremetal Server"
+	ServicedGroupDatabase         string = "DATABASE"
+	ServicedForPostgresql         string = "PostgreSQL"
+	ServicedForMariadb            string = "Mariadb"
+	ServicedForMySql              string = "MySql"
+	ServicedForEpas               string = "EPAS"
+	ServicedForSqlServer          string = "Microsoft SQL Server"
+	ServicedForTibero             string = "Tibero"
+	ServicedGroupContainer        string = "CONTAINER"
+	ServicedForKubernetesEngineVm string = "Kubernetes Engine VM"
 
 	ProductTypeDisk string = "DISK"
 
@@ -310,6 +312,73 @@ func ExternalStorageResourceSchema() *schema.Resource {
 	}
 }
 
+func ContractSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: ValidateContract,
+		Description:      "Contract : None, 1 Year, 3 Year",
+	}
+}
+
+func NextContractSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: ValidateContract,
+		Description:      "Contract applied after the current contract ends : None, 1 Year, 3 Year",
+	}
+}
+
+func ContractEndDateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "End date of the current contract",
+	}
+}
+
+// 계약 이름(None, 1 Year, 3 Year)으로 상품 그룹의 계약 상품 ID 를 찾는다.
+func FindContractProductId(contract string, productGroup *product.ProductGroupDetailResponse) (string, error) {
+	contractToId := ProductToIdMap(ProductContractDiscount, productGroup)
+	if len(contractToId) == 0 {
+		return "", fmt.Errorf("failed to find contract info")
+	}
+	contractId, ok := contractToId[contract]
+	if !ok {
+		return "", fmt.Errorf("invalid contract : %s", contract)
+	}
+	return contractId, nil
+}
+
+// 계약 값이 변경되어 API 호출이 필요한지 확인한다.
+// 생성 시 기본 계약(None)을 지정한 경우는 변경으로 보지 않는다.
+func HasContractChange(rd *schema.ResourceData, key string) bool {
+	if !rd.HasChange(key) {
+		return false
+	}
+	o, n := rd.GetChange(key)
+	if len(n.(string)) == 0 {
+		return false
+	}
+	return len(o.(string)) != 0 || n.(string) != "None"
+}
+
+func SetContract(rd *schema.ResourceData, contract string, nextContract string, contractEndDate string) error {
+	if len(nextContract) == 0 {
+		nextContract = "None"
+	}
+	if err := rd.Set("contract", contract); err != nil {
+		return err
+	}
+	if err := rd.Set("next_contract", nextContract); err != nil {
+		return err
+	}
+	return rd.Set("contract_end_date", contractEndDate)
+}
+
 func ProductToIdMap(productType string, productGroup *product.ProductGroupDetailResponse) map[string]string {
 	result := make(map[string]string)
 	if productInfos, ok := productGroup.Products[productType]; ok {
@@ -1208,3 +1208,137 @@ func ValidateContract(v interface{}, path cty.Path) diag.Diagnostics {
 
 	return diags
 }
+
+var (
+	k8sQualifiedNamePattern = regexp.MustCompile("^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$")
+	k8sDnsSubdomainPattern  = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$")
+	sysctlPattern           = regexp.MustCompile("^([a-z0-9]([-_a-z0-9]*[a-z0-9])?[./])*([a-z0-9]([-_a-z0-9]*[a-z0-9])?\\*?|\\*)$")
+)
+
+// kubelet 이 node 에 직접 설정할 수 없는 label / taint key prefix
+var k8sReservedPrefixes = []string{"kubernetes.io", "k8s.io"}
+
+// 예약된 prefix 중에서도 kubelet 이 설정할 수 있도록 허용된 prefix
+var k8sKubeletAllowedPrefixes = []string{"node.kubernetes.io", "kubelet.kubernetes.io"}
+
+func hasK8sPrefix(prefix string, candidates []string) bool {
+	for _, candidate := range candidates {
+		if prefix == candidate || strings.HasSuffix(prefix, "."+candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkK8sLabelKey(value string) error {
+	name := value
+	if i := strings.LastIndex(value, "/"); i >= 0 {
+		prefix := value[:i]
+		name = value[i+1:]
+
+		if len(prefix) == 0 || len(prefix) > 253 || !k8sDnsSubdomainPattern.MatchString(prefix) {
+			return fmt.Errorf("prefix %q must be a DNS subdomain of at most 253 characters", prefix)
+		}
+		if hasK8sPrefix(prefix, k8sReservedPrefixes) && !hasK8sPrefix(prefix, k8sKubeletAllowedPrefixes) {
+			return fmt.Errorf("prefix %q is reserved for kubernetes", prefix)
+		}
+	}
+
+	if len(name) == 0 || len(name) > 63 || !k8sQualifiedNamePattern.MatchString(name) {
+		return fmt.Errorf("name %q must be 1 to 63 alpha-numerical characters, '-', '_' or '.' and start and end with an alpha-numerical character", name)
+	}
+
+	return nil
+}
+
+func ValidateK8sLabelKey(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get attribute key
+	attr := path[len(path)-1].(cty.GetAttrStep)
+	attrKey := attr.Name
+
+	// Get value
+	value := v.(string)
+
+	err := checkK8sLabelKey(value)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Attribute %q has errors : %s", attrKey, err.Error()),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+func ValidateK8sLabelValue(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get attribute key
+	attr := path[len(path)-1].(cty.GetAttrStep)
+	attrKey := attr.Name
+
+	// Get value
+	value := v.(string)
+
+	if len(value) > 63 || (len(value) != 0 && !k8sQualifiedNamePattern.MatchString(value)) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Attribute %q must be at most 63 alpha-numerical characters, '-', '_' or '.' and start and end with an alpha-numerical character", attrKey),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+func ValidateK8sTaintEffect(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get attribute key
+	attr := path[len(path)-1].(cty.GetAttrStep)
+	attrKey := attr.Name
+
+	// Get value
+	value := v.(string)
+
+	if !regexp.MustCompile("^(NoSchedule|PreferNoSchedule|NoExecute)$").MatchString(value) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Only 'NoSchedule' or 'PreferNoSchedule' or 'NoExecute' value of Attribute %q is allowed", attrKey),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// comma 로 구분된 sysctl 이름 또는 '*' 로 끝나는 pattern 목록 (e.g. kernel.msg*,net.ipv4.route.min_pmtu)
+func ValidateSysctlPatterns(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get attribute key
+	attr := path[len(path)-1].(cty.GetAttrStep)
+	attrKey := attr.Name
+
+	// Get value
+	value := v.(string)
+	if len(value) == 0 {
+		return diags
+	}
+
+	for _, sysctl := range strings.Split(value, ",") {
+		sysctl = strings.TrimSpace(sysctl)
+		if sysctl == "*" || !sysctlPattern.MatchString(sysctl) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Attribute %q has an invalid sysctl name or pattern : %q", attrKey, sysctl),
+				AttributePath: path,
+			})
+		}
+	}
+
+	return diags
+}
@@ -119,3 +119,135 @@ func TestValidateName1to15AlphaOnlyStartsWithUpperCase(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateK8sLabelKey(t *testing.T) {
+	ctyPath := cty.Path{
+		cty.GetAttrStep{
+			Name: "key",
+		},
+	}
+
+	if ValidateK8sLabelKey("app", ctyPath).HasError() {
+		t.Error("simple name should be allowed")
+	}
+
+	if ValidateK8sLabelKey("example.com/tier_1.a-b", ctyPath).HasError() {
+		t.Error("prefixed name should be allowed")
+	}
+
+	if !ValidateK8sLabelKey("", ctyPath).HasError() {
+		t.Error("empty key should not be allowed")
+	}
+
+	if !ValidateK8sLabelKey("-app", ctyPath).HasError() {
+		t.Error("name starting with dash should not be allowed")
+	}
+
+	if !ValidateK8sLabelKey("example.com/", ctyPath).HasError() {
+		t.Error("empty name should not be allowed")
+	}
+
+	if !ValidateK8sLabelKey("Example.com/app", ctyPath).HasError() {
+		t.Error("upper case prefix should not be allowed")
+	}
+
+	if ValidateK8sLabelKey("node.kubernetes.io/role", ctyPath).HasError() {
+		t.Error("node.kubernetes.io prefix should be allowed")
+	}
+
+	if ValidateK8sLabelKey("kubelet.kubernetes.io/role", ctyPath).HasError() {
+		t.Error("kubelet.kubernetes.io prefix should be allowed")
+	}
+
+	if !ValidateK8sLabelKey("kubernetes.io/role", ctyPath).HasError() {
+		t.Error("kubernetes.io prefix should not be allowed")
+	}
+
+	if !ValidateK8sLabelKey("node-role.kubernetes.io/worker", ctyPath).HasError() {
+		t.Error("node-role.kubernetes.io prefix should not be allowed")
+	}
+
+	if !ValidateK8sLabelKey("k8s.io/role", ctyPath).HasError() {
+		t.Error("k8s.io prefix should not be allowed")
+	}
+
+	if !ValidateK8sLabelKey("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", ctyPath).HasError() {
+		t.Error("name longer than 63 characters should not be allowed")
+	}
+}
+
+func TestValidateK8sLabelValue(t *testing.T) {
+	ctyPath := cty.Path{
+		cty.GetAttrStep{
+			Name: "value",
+		},
+	}
+
+	if ValidateK8sLabelValue("", ctyPath).HasError() {
+		t.Error("empty value should be allowed")
+	}
+
+	if ValidateK8sLabelValue("v1.2_3-a", ctyPath).HasError() {
+		t.Error("alpha-numerical value with '-', '_' and '.' should be allowed")
+	}
+
+	if !ValidateK8sLabelValue("a/b", ctyPath).HasError() {
+		t.Error("slash should not be allowed")
+	}
+
+	if !ValidateK8sLabelValue("value-", ctyPath).HasError() {
+		t.Error("value ending with dash should not be allowed")
+	}
+}
+
+func TestValidateK8sTaintEffect(t *testing.T) {
+	ctyPath := cty.Path{
+		cty.GetAttrStep{
+			Name: "effect",
+		},
+	}
+
+	if ValidateK8sTaintEffect("NoSchedule", ctyPath).HasError() {
+		t.Error("NoSchedule should be allowed")
+	}
+
+	if ValidateK8sTaintEffect("NoExecute", ctyPath).HasError() {
+		t.Error("NoExecute should be allowed")
+	}
+
+	if !ValidateK8sTaintEffect("noschedule", ctyPath).HasError() {
+		t.Error("effect is case sensitive")
+	}
+}
+
+func TestValidateSysctlPatterns(t *testing.T) {
+	ctyPath := cty.Path{
+		cty.GetAttrStep{
+			Name: "allowed_unsafe_sysctls",
+		},
+	}
+
+	if ValidateSysctlPatterns("", ctyPath).HasError() {
+		t.Error("empty value should be allowed")
+	}
+
+	if ValidateSysctlPatterns("kernel.msg*,net.ipv4.route.min_pmtu", ctyPath).HasError() {
+		t.Error("sysctl names and patterns should be allowed")
+	}
+
+	if ValidateSysctlPatterns("net.core.*", ctyPath).HasError() {
+		t.Error("pattern ending with '.*' should be allowed")
+	}
+
+	if !ValidateSysctlPatterns("*", ctyPath).HasError() {
+		t.Error("wildcard only should not be allowed")
+	}
+
+	if !ValidateSysctlPatterns("kernel.msg*,net..ipv4", ctyPath).HasError() {
+		t.Error("empty segment should not be allowed")
+	}
+
+	if !ValidateSysctlPatterns("kernel.*.max", ctyPath).HasError() {
+		t.Error("wildcard in the middle should not be allowed")
+	}
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+var versionPattern = regexp.MustCompile(`\d+(\.\d+)*`)
+
+// ExtractVersion returns the first version number found in the given name. (e.g. "Ubuntu 22.04" -> "22.04")
+func ExtractVersion(name string) string {
+	return versionPattern.FindString(name)
+}
+
+// ExtractVersionPrefix returns the text before the first version number. (e.g. "Rocky Linux 8.6" -> "Rocky Linux")
+func ExtractVersionPrefix(name string) string {
+	loc := versionPattern.FindStringIndex(name)
+	if loc == nil {
+		return strings.TrimSpace(name)
+	}
+	return strings.TrimSpace(name[:loc[0]])
+}
+
+// MatchVersionConstraint checks the version against comma separated constraints. (e.g. ">= 22.04, < 24")
+func MatchVersionConstraint(v string, constraint string) (bool, error) {
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return false, fmt.Errorf("invalid version constraint %q : %s", constraint, err)
+	}
+
+	if len(v) == 0 {
+		return false, nil
+	}
+
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return false, nil
+	}
+
+	return constraints.Check(parsed), nil
+}
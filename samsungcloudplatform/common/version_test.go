@@ -0,0 +1,44 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestExtractVersion(t *testing.T) {
+	if ExtractVersion("Ubuntu 22.04") != "22.04" {
+		t.Error("version should be extracted from the image name")
+	}
+	if ExtractVersion("Windows 2019 Std") != "2019" {
+		t.Error("single number version should be extracted")
+	}
+	if ExtractVersion("Custom") != "" {
+		t.Error("name without version should return empty string")
+	}
+	if ExtractVersionPrefix("Rocky Linux 8.6") != "Rocky Linux" {
+		t.Error("distribution should be the text before the version")
+	}
+	if ExtractVersionPrefix("Custom") != "Custom" {
+		t.Error("name without version should be returned as is")
+	}
+}
+
+func TestMatchVersionConstraint(t *testing.T) {
+	if ok, err := MatchVersionConstraint("22.04", ">= 22.04"); !ok || err != nil {
+		t.Error("same version should satisfy >=")
+	}
+	if ok, err := MatchVersionConstraint("24.04", ">= 22.04, < 24"); ok || err != nil {
+		t.Error("version out of range should not match")
+	}
+	if ok, err := MatchVersionConstraint("20.04", ">= 22.04"); ok || err != nil {
+		t.Error("older version should not match")
+	}
+	if ok, err := MatchVersionConstraint("8.10", "~> 8.6"); !ok || err != nil {
+		t.Error("pessimistic constraint should allow newer minor version")
+	}
+	if ok, _ := MatchVersionConstraint("", ">= 1"); ok {
+		t.Error("empty version should not match")
+	}
+	if _, err := MatchVersionConstraint("22.04", "newer than 22"); err == nil {
+		t.Error("invalid constraint should return an error")
+	}
+}
@@ -26,6 +26,7 @@ func ResourceAutoScalingGroup() *schema.Resource {
 		ReadContext:   resourceAutoScalingGroupRead,
 		UpdateContext: resourceAutoScalingGroupUpdate,
 		DeleteContext: resourceAutoScalingGroupDelete,
+		CustomizeDiff: customizeInstanceRefreshDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -53,7 +54,7 @@ func ResourceAutoScalingGroup() *schema.Resource {
 			"lc_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Launch Configuration ID",
+				Description: "Launch Configuration ID. A launch template version is referenced with its default_lc_id, latest_lc_id or version_lc_ids.",
 			},
 			"min_server_count": {
 				Type:         schema.TypeInt,
@@ -112,6 +113,18 @@ func ResourceAutoScalingGroup() *schema.Resource {
 				ForceNew:    true,
 				Description: "File Storage ID",
 			},
+			"instance_refresh": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replace the servers of the group in batches when lc_id changes. Each batch waits until the new servers joined the load balancers attached with auto_scaling_group_load_balancer. The old servers are terminated directly, so scale-in policies and lifecycle hooks do not run for them, and the group relaunches them through its desired server count.",
+				Elem:        resourceInstanceRefresh(),
+			},
+			"refreshed_lc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Launch Configuration ID of the last successful instance refresh. A failed instance refresh is retried on the next apply.",
+			},
 			"asg_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -238,6 +251,11 @@ func resourceAutoScalingGroupRead(ctx context.Context, rd *schema.ResourceData,
 	autoscaling_common.SetResponseToResourceData(info, rd, "DeploymentEnvType", "ServiceLevelProductId", "SubnetId", "VpcId", "LocalSubnetId", "tags")
 	tfTags.SetTags(ctx, rd, meta, rd.Id())
 
+	// servers launched at creation (or before instance_refresh was set) already run lc_id
+	if len(rd.Get("refreshed_lc_id").(string)) == 0 {
+		rd.Set("refreshed_lc_id", rd.Get("lc_id"))
+	}
+
 	return nil
 }
 
@@ -351,6 +369,23 @@ func resourceAutoScalingGroupUpdate(ctx context.Context, rd *schema.ResourceData
 		}
 	}
 
+	if refreshConfig, ok := expandInstanceRefresh(rd.Get("instance_refresh").([]interface{})); ok && rd.HasChange("refreshed_lc_id") {
+		oldRefreshedLcId, _ := rd.GetChange("refreshed_lc_id")
+
+		err := waitForAutoScalingGroupStatus(ctx, inst.Client, rd.Id(), []string{"Scale Out", "Scale In", "Attach to LB", "Detach from LB"}, []string{"In Service"}, false)
+		if err == nil {
+			// the server count change is applied after the refresh, so check the desired server count currently applied
+			desiredServerCount, _ := rd.GetChange("desired_server_count")
+			err = refreshAutoScalingGroupInstances(ctx, inst.Client, rd.Id(), desiredServerCount.(int), refreshConfig)
+		}
+		if err != nil {
+			// keep the previous refreshed_lc_id so that the refresh is planned again on the next apply
+			rd.Set("refreshed_lc_id", oldRefreshedLcId)
+			return diag.FromErr(err)
+		}
+		rd.Set("refreshed_lc_id", rd.Get("lc_id"))
+	}
+
 	if rd.HasChanges("min_server_count", "desired_server_count", "max_server_count") {
 		min := int32(rd.Get("min_server_count").(int))
 		desired := int32(rd.Get("desired_server_count").(int))
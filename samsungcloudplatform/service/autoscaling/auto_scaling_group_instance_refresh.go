@@ -0,0 +1,285 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/virtualserver"
+	"github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/autoscaling2"
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	refreshReplacingState string = "REPLACING"
+	refreshReplacedState  string = "REPLACED"
+	refreshJoiningState   string = "JOINING"
+	refreshJoinedState    string = "JOINED"
+)
+
+type instanceRefreshConfig struct {
+	MinJoinedPercentage int
+	WarmupSeconds       int
+}
+
+func resourceInstanceRefresh() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"min_joined_percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      90,
+				Description:  "Percentage of servers that must stay running and joined to the load balancers while servers are being replaced. The batch size is derived from this value and the refresh is rejected when it leaves no server to replace. (0 to 99)",
+				ValidateFunc: validation.IntBetween(0, 99),
+			},
+			"warmup_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Seconds to wait after the servers of a batch joined the load balancers before the next batch starts.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func expandInstanceRefresh(list []interface{}) (instanceRefreshConfig, bool) {
+	if len(list) == 0 || list[0] == nil {
+		return instanceRefreshConfig{}, false
+	}
+	item := list[0].(map[string]interface{})
+	return instanceRefreshConfig{
+		MinJoinedPercentage: item["min_joined_percentage"].(int),
+		WarmupSeconds:       item["warmup_seconds"].(int),
+	}, true
+}
+
+// customizeInstanceRefreshDiff plans an instance refresh while the servers do not run lc_id yet. refreshed_lc_id
+// keeps the launch configuration of the last successful refresh, so a refresh that failed is planned again.
+func customizeInstanceRefreshDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	config, ok := expandInstanceRefresh(diff.Get("instance_refresh").([]interface{}))
+	if !ok {
+		return nil
+	}
+	if diff.NewValueKnown("lc_id") && diff.Get("refreshed_lc_id").(string) == diff.Get("lc_id").(string) {
+		return nil
+	}
+
+	inst := meta.(*client.Instance)
+	serverIds, err := getAsgVirtualServerIds(ctx, inst.Client, diff.Id())
+	if err != nil {
+		return err
+	}
+
+	// the server count change is applied after the refresh, so check the desired server count currently applied
+	desiredServerCount, _ := diff.GetChange("desired_server_count")
+	if len(serverIds) != 0 {
+		if _, err := getInstanceRefreshBatchSize(len(serverIds), desiredServerCount.(int), config); err != nil {
+			return fmt.Errorf("instance refresh : %s", err)
+		}
+	}
+
+	if !diff.NewValueKnown("lc_id") {
+		return diff.SetNewComputed("refreshed_lc_id")
+	}
+	return diff.SetNew("refreshed_lc_id", diff.Get("lc_id").(string))
+}
+
+// getInstanceRefreshBatchSize returns the number of servers replaced at once. The group must relaunch the terminated
+// servers through its desired server count, and a batch of at least one server must keep min_joined_percentage.
+func getInstanceRefreshBatchSize(total int, desiredServerCount int, config instanceRefreshConfig) (int, error) {
+	if desiredServerCount < total {
+		return 0, fmt.Errorf("desired server count (%d) is less than the %d servers of the group, the terminated servers would not be relaunched", desiredServerCount, total)
+	}
+
+	batchSize := total * (100 - config.MinJoinedPercentage) / 100
+	if batchSize < 1 {
+		return 0, fmt.Errorf("min_joined_percentage %d leaves no server to replace in a group of %d servers", config.MinJoinedPercentage, total)
+	}
+
+	return batchSize, nil
+}
+
+// refreshAutoScalingGroupInstances replaces the servers of the group in batches so that they are
+// relaunched with the launch configuration currently set on the group.
+// The servers are terminated directly, not through a scale-in of the group, so scale-in policies and
+// lifecycle hooks do not run for them. The group relaunches them only because its desired server count
+// stays the same, which is checked before the first batch.
+func refreshAutoScalingGroupInstances(ctx context.Context, scpClient *client.SCPClient, asgId string, desiredServerCount int, config instanceRefreshConfig) error {
+	oldServerIds, err := getAsgVirtualServerIds(ctx, scpClient, asgId)
+	if err != nil {
+		return err
+	}
+
+	total := len(oldServerIds)
+	if total == 0 {
+		return nil
+	}
+
+	batchSize, err := getInstanceRefreshBatchSize(total, desiredServerCount, config)
+	if err != nil {
+		return fmt.Errorf("instance refresh stopped : %s", err)
+	}
+
+	replacedIds := make(map[string]struct{})
+	for start := 0; start < total; start += batchSize {
+		end := start + batchSize
+		if end > total {
+			end = total
+		}
+		batchIds := oldServerIds[start:end]
+
+		log.Printf("[INFO] Auto-Scaling Group %s instance refresh : replacing servers %d-%d of %d", asgId, start+1, end, total)
+
+		for _, serverId := range batchIds {
+			_, err := scpClient.VirtualServer.DeleteVirtualServer(ctx, serverId)
+			if err != nil && !common.IsDeleted(err) {
+				return fmt.Errorf("instance refresh stopped : failed to terminate server %s : %s", serverId, err)
+			}
+		}
+
+		for _, serverId := range batchIds {
+			err = virtualserver.WaitForVirtualServerStatus(ctx, scpClient, serverId, common.VirtualServerProcessingStates(), []string{common.DeletedState}, false)
+			if err != nil {
+				return fmt.Errorf("instance refresh stopped : server %s was not terminated : %s", serverId, err)
+			}
+			replacedIds[serverId] = struct{}{}
+		}
+
+		newServerIds, err := waitForAsgReplacementServers(ctx, scpClient, asgId, replacedIds, oldServerIds, total)
+		if err != nil {
+			return fmt.Errorf("instance refresh stopped : replacement servers are not in service : %s", err)
+		}
+
+		err = waitForAsgLoadBalancerMembers(ctx, scpClient, asgId, newServerIds)
+		if err != nil {
+			return fmt.Errorf("instance refresh stopped : replacement servers did not join the load balancers : %s", err)
+		}
+
+		if config.WarmupSeconds > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("instance refresh stopped : %s", ctx.Err())
+			case <-time.After(time.Duration(config.WarmupSeconds) * time.Second):
+			}
+		}
+	}
+
+	log.Printf("[INFO] Auto-Scaling Group %s instance refresh : %d servers replaced", asgId, total)
+
+	return nil
+}
+
+func getAsgVirtualServers(ctx context.Context, scpClient *client.SCPClient, asgId string) ([]autoscaling2.AsgVirtualServerListItemResponse, error) {
+	response, _, err := scpClient.AutoScaling.GetAutoScalingGroupVirtualServerList(ctx, asgId, &autoscaling2.AsgVirtualServerV2ApiGetAsgVirtualServerListV2Opts{
+		Page: optional.NewInt32(0),
+		Size: optional.NewInt32(10000),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Contents, nil
+}
+
+func getAsgVirtualServerIds(ctx context.Context, scpClient *client.SCPClient, asgId string) ([]string, error) {
+	servers, err := getAsgVirtualServers(ctx, scpClient, asgId)
+	if err != nil {
+		return nil, err
+	}
+	serverIds := make([]string, 0, len(servers))
+	for _, server := range servers {
+		serverIds = append(serverIds, server.VirtualServerId)
+	}
+	return serverIds, nil
+}
+
+// waitForAsgReplacementServers waits until the group runs the expected number of servers again and
+// returns the IDs of the servers launched during the refresh that are now running.
+func waitForAsgReplacementServers(ctx context.Context, scpClient *client.SCPClient, asgId string, replacedIds map[string]struct{}, oldServerIds []string, expectedCount int) ([]string, error) {
+	oldIds := make(map[string]struct{})
+	for _, id := range oldServerIds {
+		oldIds[id] = struct{}{}
+	}
+
+	var newServerIds []string
+	err := client.WaitForStatus(ctx, scpClient, []string{refreshReplacingState}, []string{refreshReplacedState}, func() (interface{}, string, error) {
+		servers, err := getAsgVirtualServers(ctx, scpClient, asgId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		running := 0
+		newServerIds = make([]string, 0)
+		for _, server := range servers {
+			if _, ok := replacedIds[server.VirtualServerId]; ok {
+				continue
+			}
+			if server.VirtualServerState != common.RunningState {
+				continue
+			}
+			running++
+			if _, ok := oldIds[server.VirtualServerId]; !ok {
+				newServerIds = append(newServerIds, server.VirtualServerId)
+			}
+		}
+
+		if running < expectedCount {
+			return servers, refreshReplacingState, nil
+		}
+		return servers, refreshReplacedState, nil
+	})
+
+	return newServerIds, err
+}
+
+// waitForAsgLoadBalancerMembers waits until the given servers are enabled members of every LB server group
+// reached through the LB rules attached to the group. Groups without LB attachments return immediately.
+func waitForAsgLoadBalancerMembers(ctx context.Context, scpClient *client.SCPClient, asgId string, serverIds []string) error {
+	if len(serverIds) == 0 {
+		return nil
+	}
+
+	return client.WaitForStatus(ctx, scpClient, []string{refreshJoiningState}, []string{refreshJoinedState}, func() (interface{}, string, error) {
+		lbServices, _, err := scpClient.LoadBalancer.GetLoadBalancerServiceConnectedToAsgList(ctx, asgId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, lbService := range lbServices.Contents {
+			for _, lbRule := range lbService.LbRules {
+				if len(lbRule.LbServerGroupId) == 0 {
+					continue
+				}
+
+				serverGroup, _, err := scpClient.LoadBalancer.GetLbServerGroup(ctx, lbRule.LbServerGroupId, lbService.LoadBalancerId)
+				if err != nil {
+					return nil, "", err
+				}
+
+				joined := make(map[string]struct{})
+				for _, member := range serverGroup.LbServerGroupMembers {
+					if member.JoinState == "ENABLED" {
+						joined[member.ObjectId] = struct{}{}
+					}
+				}
+
+				for _, serverId := range serverIds {
+					if _, ok := joined[serverId]; !ok {
+						return lbServices, refreshJoiningState, nil
+					}
+				}
+			}
+		}
+
+		return lbServices, refreshJoinedState, nil
+	})
+}
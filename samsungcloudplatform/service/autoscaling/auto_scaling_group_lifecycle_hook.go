@@ -0,0 +1,166 @@
+package autoscaling
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/autoscaling/autoscaling_common"
+	"github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/autoscaling2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func init() {
+	samsungcloudplatform.RegisterResource("Auto Scaling", "samsungcloudplatform_auto_scaling_group_lifecycle_hook", ResourceAutoScalingGroupLifecycleHook())
+}
+
+func ResourceAutoScalingGroupLifecycleHook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAutoScalingGroupLifecycleHookCreate,
+		ReadContext:   resourceAutoScalingGroupLifecycleHookRead,
+		UpdateContext: resourceAutoScalingGroupLifecycleHookUpdate,
+		DeleteContext: resourceAutoScalingGroupLifecycleHookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"asg_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Auto-Scaling Group ID",
+			},
+			"lifecycle_hook_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Lifecycle hook name",
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 20),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-zA-Z0-9-]*$`), "Must be 3 to 20, start with a lowercase letter, and use English, numbers, and -."),
+				),
+			},
+			"lifecycle_transition": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Transition that pauses the server. LAUNCHING holds a new server before it joins the load balancers, TERMINATING holds a server after it left the load balancers and before it is deleted.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(LAUNCHING|TERMINATING)$`), "Must be one of \"LAUNCHING\" or \"TERMINATING\"."),
+			},
+			"heartbeat_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				Description:  "Seconds the server stays paused before the default result is applied. (30 to 7200)",
+				ValidateFunc: validation.IntBetween(30, 7200),
+			},
+			"default_result": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "CONTINUE",
+				Description:  "Result applied when the heartbeat timeout elapses. CONTINUE proceeds with the transition, ABANDON terminates a launching server.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(CONTINUE|ABANDON)$`), "Must be one of \"CONTINUE\" or \"ABANDON\"."),
+			},
+			"lifecycle_hook_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lifecycle hook ID",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The person who created the resource",
+			},
+			"created_dt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date",
+			},
+			"modified_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The person who modified the resource",
+			},
+			"modified_dt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Modification date",
+			},
+		},
+		Description: "Provides a Auto-Scaling Group lifecycle hook resource.",
+	}
+}
+
+func resourceAutoScalingGroupLifecycleHookRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	info, _, err := inst.Client.AutoScaling.GetAutoScalingGroupLifecycleHookDetail(ctx, rd.Get("asg_id").(string), rd.Id())
+	if err != nil {
+		rd.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	autoscaling_common.SetResponseToResourceData(info, rd, "ProjectId")
+
+	return nil
+}
+
+func resourceAutoScalingGroupLifecycleHookCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	heartbeatTimeoutSeconds := int32(rd.Get("heartbeat_timeout_seconds").(int))
+
+	createRequest := autoscaling2.AsgLifecycleHookCreateRequest{
+		LifecycleHookName:       rd.Get("lifecycle_hook_name").(string),
+		LifecycleTransition:     rd.Get("lifecycle_transition").(string),
+		HeartbeatTimeoutSeconds: &heartbeatTimeoutSeconds,
+		DefaultResult:           rd.Get("default_result").(string),
+	}
+
+	result, _, err := inst.Client.AutoScaling.CreateAutoScalingGroupLifecycleHook(ctx, rd.Get("asg_id").(string), createRequest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(result.LifecycleHookId)
+
+	return resourceAutoScalingGroupLifecycleHookRead(ctx, rd, meta)
+}
+
+func resourceAutoScalingGroupLifecycleHookUpdate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	if rd.HasChanges("heartbeat_timeout_seconds", "default_result") {
+		heartbeatTimeoutSeconds := int32(rd.Get("heartbeat_timeout_seconds").(int))
+
+		updateRequest := autoscaling2.AsgLifecycleHookUpdateRequest{
+			HeartbeatTimeoutSeconds: &heartbeatTimeoutSeconds,
+			DefaultResult:           rd.Get("default_result").(string),
+		}
+
+		_, _, err := inst.Client.AutoScaling.UpdateAutoScalingGroupLifecycleHook(ctx, rd.Get("asg_id").(string), rd.Id(), updateRequest)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAutoScalingGroupLifecycleHookRead(ctx, rd, meta)
+}
+
+func resourceAutoScalingGroupLifecycleHookDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	_, err := inst.Client.AutoScaling.DeleteAutoScalingGroupLifecycleHook(ctx, rd.Get("asg_id").(string), rd.Id())
+	if err != nil && !common.IsDeleted(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/autoscaling/autoscaling_common"
+	"github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/autoscaling2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func init() {
+	samsungcloudplatform.RegisterResource("Auto Scaling", "samsungcloudplatform_auto_scaling_group_notification", ResourceAutoScalingGroupNotification())
+}
+
+func ResourceAutoScalingGroupNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAutoScalingGroupNotificationCreate,
+		ReadContext:   resourceAutoScalingGroupNotificationRead,
+		UpdateContext: resourceAutoScalingGroupNotificationUpdate,
+		DeleteContext: resourceAutoScalingGroupNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"asg_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Auto-Scaling Group ID",
+			},
+			"notification_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Notification type",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(WEBHOOK|EMAIL)$`), "Must be one of \"WEBHOOK\" or \"EMAIL\"."),
+			},
+			"webhook_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Webhook URL to post the events to. Required when notification_type is WEBHOOK.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"email_recipients": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Email addresses to send the events to. Required when notification_type is EMAIL.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`), "Must be an email address."),
+				},
+			},
+			"events": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Events to notify. (SCALE_OUT, SCALE_IN, LAUNCH_FAILED, TERMINATE_FAILED, LIFECYCLE_LAUNCHING, LIFECYCLE_TERMINATING)",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(SCALE_OUT|SCALE_IN|LAUNCH_FAILED|TERMINATE_FAILED|LIFECYCLE_LAUNCHING|LIFECYCLE_TERMINATING)$`), "Must be one of \"SCALE_OUT\", \"SCALE_IN\", \"LAUNCH_FAILED\", \"TERMINATE_FAILED\", \"LIFECYCLE_LAUNCHING\" or \"LIFECYCLE_TERMINATING\"."),
+				},
+			},
+			"notification_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Notification ID",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The person who created the resource",
+			},
+			"created_dt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date",
+			},
+			"modified_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The person who modified the resource",
+			},
+			"modified_dt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Modification date",
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			switch diff.Get("notification_type").(string) {
+			case "WEBHOOK":
+				if len(diff.Get("webhook_url").(string)) == 0 {
+					return fmt.Errorf("webhook_url is required when notification_type is WEBHOOK")
+				}
+				if diff.Get("email_recipients").(*schema.Set).Len() > 0 {
+					return fmt.Errorf("email_recipients can only be used when notification_type is EMAIL")
+				}
+			case "EMAIL":
+				if diff.Get("email_recipients").(*schema.Set).Len() == 0 {
+					return fmt.Errorf("email_recipients is required when notification_type is EMAIL")
+				}
+				if len(diff.Get("webhook_url").(string)) > 0 {
+					return fmt.Errorf("webhook_url can only be used when notification_type is WEBHOOK")
+				}
+			}
+			return nil
+		},
+		Description: "Provides a Auto-Scaling Group notification resource.",
+	}
+}
+
+func resourceAutoScalingGroupNotificationRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	info, _, err := inst.Client.AutoScaling.GetAutoScalingGroupNotificationDetail(ctx, rd.Get("asg_id").(string), rd.Id())
+	if err != nil {
+		rd.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	autoscaling_common.SetResponseToResourceData(info, rd, "ProjectId")
+
+	return nil
+}
+
+func resourceAutoScalingGroupNotificationCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	createRequest := autoscaling2.AsgNotificationCreateRequest{
+		NotificationType: rd.Get("notification_type").(string),
+		WebhookUrl:       rd.Get("webhook_url").(string),
+		EmailRecipients:  common.ToStringList(rd.Get("email_recipients").(*schema.Set).List()),
+		Events:           common.ToStringList(rd.Get("events").(*schema.Set).List()),
+	}
+
+	result, _, err := inst.Client.AutoScaling.CreateAutoScalingGroupNotification(ctx, rd.Get("asg_id").(string), createRequest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(result.NotificationId)
+
+	return resourceAutoScalingGroupNotificationRead(ctx, rd, meta)
+}
+
+func resourceAutoScalingGroupNotificationUpdate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	if rd.HasChanges("webhook_url", "email_recipients", "events") {
+		updateRequest := autoscaling2.AsgNotificationUpdateRequest{
+			WebhookUrl:      rd.Get("webhook_url").(string),
+			EmailRecipients: common.ToStringList(rd.Get("email_recipients").(*schema.Set).List()),
+			Events:          common.ToStringList(rd.Get("events").(*schema.Set).List()),
+		}
+
+		_, _, err := inst.Client.AutoScaling.UpdateAutoScalingGroupNotification(ctx, rd.Get("asg_id").(string), rd.Id(), updateRequest)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAutoScalingGroupNotificationRead(ctx, rd, meta)
+}
+
+func resourceAutoScalingGroupNotificationDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	_, err := inst.Client.AutoScaling.DeleteAutoScalingGroupNotification(ctx, rd.Get("asg_id").(string), rd.Id())
+	if err != nil && !common.IsDeleted(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
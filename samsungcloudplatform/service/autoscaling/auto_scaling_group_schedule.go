@@ -0,0 +1,264 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/autoscaling/autoscaling_common"
+	"github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/autoscaling2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func init() {
+	samsungcloudplatform.RegisterResource("Auto Scaling", "samsungcloudplatform_auto_scaling_group_schedule", ResourceAutoScalingGroupSchedule())
+}
+
+func ResourceAutoScalingGroupSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAutoScalingGroupScheduleCreate,
+		ReadContext:   resourceAutoScalingGroupScheduleRead,
+		UpdateContext: resourceAutoScalingGroupScheduleUpdate,
+		DeleteContext: resourceAutoScalingGroupScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"asg_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Auto-Scaling Group ID",
+			},
+			"schedule_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Schedule name",
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 20),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-zA-Z0-9-]*$`), "Must be 3 to 20, start with a lowercase letter, and use English, numbers, and -."),
+				),
+			},
+			"min_server_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Min server count to apply when the schedule runs",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"desired_server_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Desired server count to apply when the schedule runs",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_server_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Max server count to apply when the schedule runs",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Schedule frequency",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(ONCE|DAILY|WEEKLY|MONTHLY)$`), "Must be one of \"ONCE\", \"DAILY\", \"WEEKLY\" or \"MONTHLY\"."),
+			},
+			"day_of_week": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Days of the week to run the schedule. Required when frequency is WEEKLY. (SUN, MON, TUE, WED, THU, FRI, SAT)",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(SUN|MON|TUE|WED|THU|FRI|SAT)$`), "Must be one of \"SUN\", \"MON\", \"TUE\", \"WED\", \"THU\", \"FRI\" or \"SAT\"."),
+				},
+			},
+			"day_of_month": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Day of the month to run the schedule. Required when frequency is MONTHLY. (1 to 31)",
+				ValidateFunc: validation.IntBetween(1, 31),
+			},
+			"execution_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Time of the day to run the schedule. (HH:mm)",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "Must be HH:mm format."),
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Start date of the schedule. (yyyy-MM-dd)",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "Must be yyyy-MM-dd format."),
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "End date of the schedule. Not used when frequency is ONCE. (yyyy-MM-dd)",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "Must be yyyy-MM-dd format."),
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Asia/Seoul",
+				Description: "Timezone of the execution time",
+			},
+			"schedule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Schedule ID",
+			},
+			"schedule_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Schedule state",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The person who created the resource",
+			},
+			"created_dt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date",
+			},
+			"modified_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The person who modified the resource",
+			},
+			"modified_dt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Modification date",
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			min := int32(diff.Get("min_server_count").(int))
+			desired := int32(diff.Get("desired_server_count").(int))
+			max := int32(diff.Get("max_server_count").(int))
+			if err := validateServerCount(min, desired, max); err != nil {
+				return err
+			}
+			return validateScheduleFrequency(diff.Get("frequency").(string), diff.Get("day_of_week").(*schema.Set).Len(), diff.Get("day_of_month").(int))
+		},
+		Description: "Provides a Auto-Scaling Group schedule resource.",
+	}
+}
+
+func validateScheduleFrequency(frequency string, dayOfWeekCount int, dayOfMonth int) error {
+	switch frequency {
+	case "WEEKLY":
+		if dayOfWeekCount == 0 {
+			return fmt.Errorf("day_of_week is required when frequency is WEEKLY")
+		}
+	case "MONTHLY":
+		if dayOfMonth == 0 {
+			return fmt.Errorf("day_of_month is required when frequency is MONTHLY")
+		}
+	}
+	if frequency != "WEEKLY" && dayOfWeekCount > 0 {
+		return fmt.Errorf("day_of_week can only be used when frequency is WEEKLY")
+	}
+	if frequency != "MONTHLY" && dayOfMonth != 0 {
+		return fmt.Errorf("day_of_month can only be used when frequency is MONTHLY")
+	}
+	return nil
+}
+
+func resourceAutoScalingGroupScheduleRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	info, _, err := inst.Client.AutoScaling.GetAutoScalingGroupScheduleDetail(ctx, rd.Get("asg_id").(string), rd.Id())
+	if err != nil {
+		rd.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	autoscaling_common.SetResponseToResourceData(info, rd, "ProjectId", "BlockId", "ServiceZoneId")
+
+	return nil
+}
+
+func resourceAutoScalingGroupScheduleCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	asgId := rd.Get("asg_id").(string)
+	minServerCount := int32(rd.Get("min_server_count").(int))
+	desiredServerCount := int32(rd.Get("desired_server_count").(int))
+	maxServerCount := int32(rd.Get("max_server_count").(int))
+	dayOfMonth := int32(rd.Get("day_of_month").(int))
+
+	createRequest := autoscaling2.AsgScheduleCreateRequest{
+		ScheduleName:       rd.Get("schedule_name").(string),
+		MinServerCount:     &minServerCount,
+		DesiredServerCount: &desiredServerCount,
+		MaxServerCount:     &maxServerCount,
+		Frequency:          rd.Get("frequency").(string),
+		DayOfWeek:          common.ToStringList(rd.Get("day_of_week").(*schema.Set).List()),
+		DayOfMonth:         &dayOfMonth,
+		ExecutionTime:      rd.Get("execution_time").(string),
+		StartDate:          rd.Get("start_date").(string),
+		EndDate:            rd.Get("end_date").(string),
+		Timezone:           rd.Get("timezone").(string),
+	}
+
+	result, _, err := inst.Client.AutoScaling.CreateAutoScalingGroupSchedule(ctx, asgId, createRequest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(result.ScheduleId)
+
+	return resourceAutoScalingGroupScheduleRead(ctx, rd, meta)
+}
+
+func resourceAutoScalingGroupScheduleUpdate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	asgId := rd.Get("asg_id").(string)
+	minServerCount := int32(rd.Get("min_server_count").(int))
+	desiredServerCount := int32(rd.Get("desired_server_count").(int))
+	maxServerCount := int32(rd.Get("max_server_count").(int))
+	dayOfMonth := int32(rd.Get("day_of_month").(int))
+
+	updateRequest := autoscaling2.AsgScheduleUpdateRequest{
+		ScheduleName:       rd.Get("schedule_name").(string),
+		MinServerCount:     &minServerCount,
+		DesiredServerCount: &desiredServerCount,
+		MaxServerCount:     &maxServerCount,
+		Frequency:          rd.Get("frequency").(string),
+		DayOfWeek:          common.ToStringList(rd.Get("day_of_week").(*schema.Set).List()),
+		DayOfMonth:         &dayOfMonth,
+		ExecutionTime:      rd.Get("execution_time").(string),
+		StartDate:          rd.Get("start_date").(string),
+		EndDate:            rd.Get("end_date").(string),
+		Timezone:           rd.Get("timezone").(string),
+	}
+
+	_, _, err := inst.Client.AutoScaling.UpdateAutoScalingGroupSchedule(ctx, asgId, rd.Id(), updateRequest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAutoScalingGroupScheduleRead(ctx, rd, meta)
+}
+
+func resourceAutoScalingGroupScheduleDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	_, err := inst.Client.AutoScaling.DeleteAutoScalingGroupSchedule(ctx, rd.Get("asg_id").(string), rd.Id())
+	if err != nil && !common.IsDeleted(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -33,7 +33,7 @@ func ResourceLaunchConfiguration() *schema.Resource {
 				Type:        schema.TypeList,
 				Required:    true,
 				ForceNew:    true,
-				Elem:        resourceLaunchConfigurationBlockStorageElem(),
+				Elem:        resourceLaunchConfigurationBlockStorageElem(true),
 				Description: "Block Storage list",
 			},
 			"image_id": {
@@ -148,33 +148,35 @@ func ResourceLaunchConfiguration() *schema.Resource {
 	}
 }
 
-func resourceLaunchConfigurationBlockStorageElem() *schema.Resource {
+// resourceLaunchConfigurationBlockStorageElem is shared with launch templates, which create a new version
+// instead of replacing the resource when a block storage changes.
+func resourceLaunchConfigurationBlockStorageElem(forceNew bool) *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"block_storage_size": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
+				ForceNew:     forceNew,
 				Description:  "Block Storage size (GB)",
 				ValidateFunc: validation.IntAtLeast(4),
 			},
 			"disk_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
+				ForceNew:     forceNew,
 				Description:  "Block Storage product (default value : SSD)",
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`SSD|HDD`), "Must be one of \"SSD\" or \"HDD\"."),
 			},
 			"encryption_enabled": {
 				Type:        schema.TypeBool,
 				Required:    true,
-				ForceNew:    true,
+				ForceNew:    forceNew,
 				Description: "Encryption enabled",
 			},
 			"is_boot_disk": {
 				Type:        schema.TypeBool,
 				Required:    true,
-				ForceNew:    true,
+				ForceNew:    forceNew,
 				Description: "Is boot disk or not",
 			},
 			"product_id": {
@@ -0,0 +1,316 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/autoscaling/autoscaling_common"
+	tfTags "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/tag"
+	"github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/autoscaling2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	uuid "github.com/satori/go.uuid"
+)
+
+// launchTemplateVersionFields are the arguments stored in each launch configuration version.
+// Changing one of them creates a new version instead of replacing the launch template.
+var launchTemplateVersionFields = []string{"block_storages", "image_id", "initial_script", "key_pair_id", "server_type"}
+
+func init() {
+	samsungcloudplatform.RegisterResource("Auto Scaling", "samsungcloudplatform_launch_template", ResourceLaunchTemplate())
+}
+
+func ResourceLaunchTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLaunchTemplateCreate,
+		ReadContext:   resourceLaunchTemplateRead,
+		UpdateContext: resourceLaunchTemplateUpdate,
+		DeleteContext: resourceLaunchTemplateDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Launch template name. Each version is created as a launch configuration named with this prefix and a unique suffix. (3 to 11 characters, starts with a lowercase letter, and uses lowercase letters, numbers and -)",
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 11),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]*$`), "Must be 3 to 11 characters, starts with a lowercase letter, and uses lowercase letters, numbers and -."),
+				),
+			},
+			"service_zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Service zone ID",
+			},
+			"block_storages": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        resourceLaunchConfigurationBlockStorageElem(false),
+				Description: "Block Storage list",
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Image ID",
+			},
+			"initial_script": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Virtual Server's initial script",
+			},
+			"key_pair_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key pair ID",
+			},
+			"server_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Server type",
+			},
+			"default_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Version referenced by default_lc_id. Follows the latest version when not set.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest version",
+			},
+			"default_lc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Launch Configuration ID of the default version",
+			},
+			"latest_lc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Launch Configuration ID of the latest version",
+			},
+			"version_lc_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Launch Configuration ID of each version, keyed by version number",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Launch template versions",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version":    {Type: schema.TypeInt, Computed: true, Description: "Version number"},
+						"lc_id":      {Type: schema.TypeString, Computed: true, Description: "Launch Configuration ID"},
+						"lc_name":    {Type: schema.TypeString, Computed: true, Description: "Launch Configuration name"},
+						"image_id":   {Type: schema.TypeString, Computed: true, Description: "Image ID"},
+						"created_dt": {Type: schema.TypeString, Computed: true, Description: "Creation date"},
+					},
+				},
+			},
+			"tags": tfTags.TagsSchema(),
+		},
+		CustomizeDiff: resourceLaunchTemplateDiff,
+		Description:   "Provides a versioned Launch Template resource. Every change of the launch settings creates a new launch configuration version, so Auto-Scaling Groups can move between versions without replacing the template.",
+	}
+}
+
+func resourceLaunchTemplateDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	latestVersion := diff.Get("latest_version").(int)
+	defaultVersionSet := !diff.GetRawConfig().GetAttr("default_version").IsNull()
+
+	if diff.HasChanges(launchTemplateVersionFields...) {
+		latestVersion++
+		diff.SetNewComputed("latest_version")
+		diff.SetNewComputed("latest_lc_id")
+		diff.SetNewComputed("version_lc_ids")
+		diff.SetNewComputed("versions")
+		if !defaultVersionSet {
+			diff.SetNewComputed("default_version")
+			diff.SetNewComputed("default_lc_id")
+		}
+	}
+
+	defaultVersion := diff.Get("default_version").(int)
+	versionLcIds, _ := diff.GetChange("version_lc_ids")
+	_, defaultVersionExists := versionLcIds.(map[string]interface{})[strconv.Itoa(defaultVersion)]
+
+	if defaultVersionSet {
+		if defaultVersion > latestVersion {
+			return fmt.Errorf("default_version %d does not exist. The latest version is %d", defaultVersion, latestVersion)
+		}
+		// 이번 apply 에서 만들어질 최신 버전이 아니면 삭제된 버전을 가리키지 않는지 확인한다.
+		if !defaultVersionExists && !(diff.HasChanges(launchTemplateVersionFields...) && defaultVersion == latestVersion) {
+			return fmt.Errorf("default_version %d no longer exists. Set default_version to an existing version", defaultVersion)
+		}
+		if diff.HasChange("default_version") {
+			diff.SetNewComputed("default_lc_id")
+		}
+	} else if !diff.HasChanges(launchTemplateVersionFields...) && !defaultVersionExists {
+		// 기본 버전이 삭제되었으면 최신 버전을 따르도록 plan 에 보여준다.
+		if err := diff.SetNew("default_version", latestVersion); err != nil {
+			return err
+		}
+		diff.SetNewComputed("default_lc_id")
+	}
+
+	return nil
+}
+
+func resourceLaunchTemplateCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	version, err := createLaunchTemplateVersion(ctx, inst.Client, rd, 1)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(uuid.NewV4().String())
+	rd.Set("versions", []interface{}{version})
+	rd.Set("latest_version", 1)
+	if rd.Get("default_version").(int) == 0 {
+		rd.Set("default_version", 1)
+	}
+
+	return resourceLaunchTemplateRead(ctx, rd, meta)
+}
+
+func resourceLaunchTemplateRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	versions := make([]interface{}, 0)
+	versionLcIds := make(map[string]interface{})
+	var latest map[string]interface{}
+	var latestDetail autoscaling2.LaunchConfigDetailV4Response
+	for _, v := range rd.Get("versions").([]interface{}) {
+		version := v.(map[string]interface{})
+
+		response, _, err := inst.Client.AutoScaling.GetLaunchConfigurationDetail(ctx, version["lc_id"].(string))
+		if err != nil {
+			if common.IsDeleted(err) {
+				// 외부에서 삭제된 버전은 목록에서 제외
+				continue
+			}
+			return diag.FromErr(err)
+		}
+
+		version["lc_name"] = response.LcName
+		version["image_id"] = response.ImageId
+		version["created_dt"] = response.CreatedDt.Format(time.RFC3339)
+		versions = append(versions, version)
+		versionLcIds[strconv.Itoa(version["version"].(int))] = response.LcId
+
+		latest = version
+		latestDetail = response
+	}
+
+	if latest == nil {
+		rd.SetId("")
+		return nil
+	}
+
+	rd.Set("versions", versions)
+	rd.Set("version_lc_ids", versionLcIds)
+	rd.Set("latest_version", latest["version"])
+	rd.Set("latest_lc_id", latest["lc_id"])
+
+	// 기본 버전이 삭제된 경우 default_version 은 그대로 두고 plan 에서 차이를 보여준다.
+	defaultVersion := rd.Get("default_version").(int)
+	rd.Set("default_lc_id", versionLcIds[strconv.Itoa(defaultVersion)])
+
+	rd.Set("service_zone_id", latestDetail.ServiceZoneId)
+	rd.Set("image_id", latestDetail.ImageId)
+	rd.Set("initial_script", latestDetail.InitialScript)
+	rd.Set("key_pair_id", latestDetail.KeyPairId)
+	rd.Set("server_type", latestDetail.ServerType)
+	rd.Set("block_storages", autoscaling_common.ConvertFieldValue(latestDetail.BlockStorages))
+	tfTags.SetTags(ctx, rd, meta, latestDetail.LcId)
+
+	return nil
+}
+
+func resourceLaunchTemplateUpdate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	versions := rd.Get("versions").([]interface{})
+
+	for _, v := range versions {
+		err := tfTags.UpdateTags(ctx, rd, meta, v.(map[string]interface{})["lc_id"].(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if rd.HasChanges(launchTemplateVersionFields...) {
+		latestVersion := rd.Get("latest_version").(int) + 1
+
+		version, err := createLaunchTemplateVersion(ctx, inst.Client, rd, latestVersion)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		rd.Set("versions", append(versions, version))
+		rd.Set("latest_version", latestVersion)
+		if rd.GetRawConfig().GetAttr("default_version").IsNull() {
+			rd.Set("default_version", latestVersion)
+		}
+	}
+
+	return resourceLaunchTemplateRead(ctx, rd, meta)
+}
+
+func resourceLaunchTemplateDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	versions := rd.Get("versions").([]interface{})
+	for i := len(versions) - 1; i >= 0; i-- {
+		lcId := versions[i].(map[string]interface{})["lc_id"].(string)
+		_, err := inst.Client.AutoScaling.DeleteLaunchConfigurationGroup(ctx, lcId)
+		if err != nil && !common.IsDeleted(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// createLaunchTemplateVersion creates the launch configuration of a new version. The launch configuration name
+// gets a unique suffix so that a replacement template can be created before the old one is destroyed.
+func createLaunchTemplateVersion(ctx context.Context, scpClient *client.SCPClient, rd *schema.ResourceData, version int) (map[string]interface{}, error) {
+	lcName := fmt.Sprintf("%s-%s", rd.Get("name").(string), strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 36))
+
+	response, _, err := scpClient.AutoScaling.CreateLaunchConfigurationGroup(ctx, autoscaling2.LaunchConfigCreateV6Request{
+		BlockStorages: convertBlockStorages(rd.Get("block_storages").(common.HclListObject)),
+		ImageId:       rd.Get("image_id").(string),
+		InitialScript: rd.Get("initial_script").(string),
+		KeyPairId:     rd.Get("key_pair_id").(string),
+		LcName:        lcName,
+		ServerType:    rd.Get("server_type").(string),
+		ServiceZoneId: rd.Get("service_zone_id").(string),
+	}, rd.Get("tags").(map[string]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create launch template version %d : %s", version, err)
+	}
+
+	return map[string]interface{}{
+		"version":    version,
+		"lc_id":      response.LcId,
+		"lc_name":    response.LcName,
+		"image_id":   response.ImageId,
+		"created_dt": "",
+	}, nil
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/image"
 	tfTags "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/tag"
+	baremetal2 "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/bare-metal-server"
+	"github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/product"
 	publicip2 "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/public-ip2"
 	"github.com/antihax/optional"
 	"github.com/hashicorp/go-cty/cty"
@@ -43,10 +45,11 @@ func ResourceBareMetalServer() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"servers": {
-				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
-				MaxItems: 5,
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    5,
+				Description: "Bare-metal servers. Servers are identified by bm_server_name, so added or removed entries do not affect the other servers. Renaming a server is not allowed.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"bm_server_name": {
@@ -132,10 +135,17 @@ func ResourceBareMetalServer() *schema.Resource {
 				ValidateDiagFunc: common.ValidatePositiveInt,
 			},
 			"contract_discount": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Contract : None, 1-year, 3-year",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"contract"},
+				ValidateDiagFunc: common.ValidateContract,
+				Deprecated:       "Use contract instead.",
+				Description:      "Contract : None, 1 Year, 3 Year",
 			},
+			"contract":          common.ContractSchema(),
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
 			"block_storages": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -176,15 +186,13 @@ func ResourceBareMetalServer() *schema.Resource {
 			"image_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "Image id of this bare-metal server",
+				Description: "Image id of this bare-metal server. Changing this reinstalls the OS of the servers. (delete_protection must be disabled)",
 			},
 			"initial_script": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Default:     "",
-				Description: "Initialization script",
+				Description: "Initialization script. Changing this reinstalls the OS of the servers. (delete_protection must be disabled)",
 			},
 			"subnet_id": {
 				Type:        schema.TypeString,
@@ -206,10 +214,38 @@ func ResourceBareMetalServer() *schema.Resource {
 			},
 			"tags": tfTags.TagsSchema(),
 		},
-		Description: "Provides a Bare-metal Server resource.",
+		CustomizeDiff: resourceBareMetalServerDiff,
+		Description:   "Provides a Bare-metal Server resource.",
 	}
 }
 
+func resourceBareMetalServerDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	deleteProtection := diff.Get("delete_protection").(bool)
+
+	if deleteProtection && (diff.HasChange("image_id") || diff.HasChange("initial_script")) {
+		return fmt.Errorf("OS reinstall is not allowed while delete_protection is enabled")
+	}
+
+	if diff.HasChange("servers") {
+		o, n := diff.GetChange("servers")
+		removed, added := getServerNameDiff(o.(common.HclListObject), n.(common.HclListObject))
+
+		// 서버는 이름으로 구분하므로 이름 변경은 기존 서버 삭제 + 새 서버 생성이 된다. 의도치 않은 삭제를 막기 위해 같은 apply 에서는 허용하지 않는다.
+		if len(removed) != 0 && len(added) != 0 {
+			return fmt.Errorf("servers %s can not be renamed or replaced by %s in place (the physical servers would be deleted). Remove and add servers in separate applies", strings.Join(removed, ", "), strings.Join(added, ", "))
+		}
+		if deleteProtection && len(removed) != 0 {
+			return fmt.Errorf("servers %s can not be removed while delete_protection is enabled", strings.Join(removed, ", "))
+		}
+	}
+
+	return nil
+}
+
 func checkStringLength(str string, min int, max int) error {
 	if len(str) < min {
 		return fmt.Errorf("input must be longer than %v characters", min)
@@ -352,84 +388,123 @@ func ConvertBaremetalServerList(list common.HclListObject, storageList []baremet
 	return result, nil
 }
 
-func convertInterfaceToStringList(list common.HclListObject, key string) ([]string, error) {
+// servers block 의 이전/새 값에서 제거된 서버 이름과 추가된 서버 이름을 반환
+func getServerNameDiff(oldServers common.HclListObject, newServers common.HclListObject) ([]string, []string) {
+	oldNames := make(map[string]bool)
+	for _, itemObject := range oldServers {
+		oldNames[itemObject.(common.HclKeyValueObject)["bm_server_name"].(string)] = true
+	}
+	newNames := make(map[string]bool)
+	for _, itemObject := range newServers {
+		newNames[itemObject.(common.HclKeyValueObject)["bm_server_name"].(string)] = true
+	}
 
-	output := make([]string, 0)
+	removed := make([]string, 0)
+	for _, itemObject := range oldServers {
+		name := itemObject.(common.HclKeyValueObject)["bm_server_name"].(string)
+		if !newNames[name] {
+			removed = append(removed, name)
+		}
+	}
 
-	for _, itemObject := range list {
-		item := itemObject.(common.HclKeyValueObject)
-		if v, ok := item[key]; ok {
-			output = append(output, v.(string))
-		} else {
-			return nil, fmt.Errorf("%q value must be exist", key)
+	added := make([]string, 0)
+	for _, itemObject := range newServers {
+		name := itemObject.(common.HclKeyValueObject)["bm_server_name"].(string)
+		if !oldNames[name] {
+			added = append(added, name)
 		}
 	}
 
-	return output, nil
+	return removed, added
 }
 
-// servers block에서 update 시에 달라진 index와 새로운 값을 반환시켜준다.
-func getChangeDiffIndex(rd *schema.ResourceData, key string) ([]int, []string, error) {
-	o, n := rd.GetChange("servers")
+func resourceBareMetalServerCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) (diagnostics diag.Diagnostics) {
+	var err error = nil
+	defer func() {
+		if err != nil {
+			diagnostics = diag.FromErr(err)
+		}
+	}()
 
-	oldValue, err := convertInterfaceToStringList(o.(common.HclListObject), key)
+	inst := meta.(*client.Instance)
+
+	servers := rd.Get("servers").(common.HclListObject)
+
+	createRequest, err := getBareMetalServerCreateRequest(ctx, rd, meta, servers)
 	if err != nil {
-		return nil, nil, err
+		return
 	}
 
-	newValue, err := convertInterfaceToStringList(n.(common.HclListObject), key)
+	createResponse, err := inst.Client.BareMetal.CreateBareMetalServer(ctx, createRequest, rd.Get("tags").(map[string]interface{}))
 	if err != nil {
-		return nil, nil, err
+		return
 	}
 
-	idxList := make([]int, 0)
+	resourceIds := strings.Split(createResponse.ResourceId, ",")
 
-	for index, _ := range oldValue {
-		if oldValue[index] != newValue[index] {
-			idxList = append(idxList, index)
+	for _, resourceId := range resourceIds {
+		err = WaitForBMServerStatus(ctx, inst.Client, resourceId, common.VirtualServerProcessingStates(), []string{common.RunningState}, true)
+		if err != nil {
+			return
 		}
 	}
-	return idxList, newValue, nil
-}
 
-func resourceBareMetalServerCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) (diagnostics diag.Diagnostics) {
-	var err error = nil
-	defer func() {
+	rd.SetId(createResponse.ResourceId)
+
+	// 다음 약정은 생성 요청에 포함되지 않으므로 생성 후 변경한다.
+	if common.HasContractChange(rd, "next_contract") {
+		var productGroup product.ProductGroupDetailResponse
+		productGroup, err = inst.Client.Product.GetProductGroup(ctx, createRequest.ProductGroupId)
 		if err != nil {
-			diagnostics = diag.FromErr(err)
+			return
 		}
-	}()
 
+		var nextContractId string
+		nextContractId, err = common.FindContractProductId(rd.Get("next_contract").(string), &productGroup)
+		if err != nil {
+			return
+		}
+
+		for _, resourceId := range resourceIds {
+			_, err = inst.Client.BareMetal.ChangeBMNextContract(ctx, resourceId, nextContractId)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return resourceBareMetalServerRead(ctx, rd, meta)
+}
+
+// 공통 설정과 주어진 servers 목록으로 생성 요청을 만든다. (update 시 추가되는 서버에도 사용)
+func getBareMetalServerCreateRequest(ctx context.Context, rd *schema.ResourceData, meta interface{}, servers common.HclListObject) (baremetal.BMServerCreateRequest, error) {
 	inst := meta.(*client.Instance)
 
 	isDeleteProtected := rd.Get("delete_protection").(bool)
 	cpuCount := rd.Get("cpu_count").(int)
 	memorySizeGB := rd.Get("memory_size_gb").(int)
 
-	contractDiscount := rd.Get("contract_discount").(string)
+	contract := getBareMetalContract(rd)
 	blockStorageList := rd.Get("block_storages").(common.HclListObject)
 
 	subnetId := rd.Get("subnet_id").(string)
 
-	adminAccount := rd.Get("admin_account").(string)
 	adminPassword := rd.Get("admin_password").(string)
 	initialScript := rd.Get("initial_script").(string)
 
 	vpcId := rd.Get("vpc_id").(string)
 	imageId := rd.Get("image_id").(string)
 
-	servers := rd.Get("servers").(common.HclListObject)
-
 	// Get vpc info
 	vpcInfo, _, err := inst.Client.Vpc.GetVpcInfo(ctx, vpcId)
 	if err != nil {
-		return
+		return baremetal.BMServerCreateRequest{}, err
 	}
 
 	blockId := ""
 	projectDetails, err := inst.Client.Project.GetProjectInfo(ctx)
 	if err != nil {
-		return diag.FromErr(err)
+		return baremetal.BMServerCreateRequest{}, err
 	}
 	for _, zone := range projectDetails.ServiceZones {
 		if zone.ServiceZoneId == vpcInfo.ServiceZoneId {
@@ -437,79 +512,49 @@ func resourceBareMetalServerCreate(ctx context.Context, rd *schema.ResourceData,
 		}
 	}
 	if blockId == "" {
-		return diag.FromErr(errors.New("vpc info is not valid"))
+		return baremetal.BMServerCreateRequest{}, errors.New("vpc info is not valid")
 	}
 
-	isOsWindows, targetProductGroupId, err := getImageInfo(ctx, vpcInfo.ServiceZoneId, imageId, meta)
+	adminAccount, targetProductGroupId, err := getAdminAccountAndProductGroupId(ctx, rd, meta, vpcInfo.ServiceZoneId, imageId, servers)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if !isOsWindows && adminAccount != common.LinuxAdminAccount {
-		adminAccount = common.LinuxAdminAccount
-		log.Println("Linux admin account must be root")
-	}
-
-	if isOsWindows && (adminAccount == common.WindowsAdminAccount || len(adminAccount) < 5) {
-		diagnostics = diag.Errorf("Windows admin account must be 5 to 20 alpha-numeric characters with special character and not be 'administrator'.")
-		return
-	}
-
-	if len(targetProductGroupId) == 0 {
-		diagnostics = diag.Errorf("Product group id not found from image")
-		return
-	}
-
-	if isOsWindows {
-		err := common.ValidateServerNameInWindowImage(servers)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+		return baremetal.BMServerCreateRequest{}, err
 	}
 
 	// Get product group information
 	productGroup, err := inst.Client.Product.GetProductGroup(ctx, targetProductGroupId)
 	//productGroup, err := inst.Client.Product.GetProducesList(ctx, vpcInfo.ServiceZoneId, targetProductGroupId, "")
 	if err != nil {
-		return diag.FromErr(err)
+		return baremetal.BMServerCreateRequest{}, err
 	}
 
 	diskProductNameToId := common.ProductToIdMap(common.ProductDisk, &productGroup)
 	if len(diskProductNameToId) == 0 {
-		diagnostics = diag.Errorf("Failed to find external disk product")
-		return
-	}
-
-	contractToId := common.ProductToIdMap(common.ProductContractDiscount, &productGroup)
-	if len(contractToId) == 0 {
-		diagnostics = diag.Errorf("Failed to find contract info")
-		return
+		return baremetal.BMServerCreateRequest{}, errors.New("failed to find external disk product")
 	}
 
 	// Find bare-metal scaling
 	scaleId, err := client.FindScaleProduct(ctx, inst.Client, targetProductGroupId, cpuCount, memorySizeGB)
 	if err != nil {
-		return
+		return baremetal.BMServerCreateRequest{}, err
 	}
 
 	// Find contract
-	contractId, ok := contractToId[contractDiscount]
-	if !ok {
-		diagnostics = diag.Errorf("Invalid contract")
-		return
+	contractId, err := common.FindContractProductId(contract, &productGroup)
+	if err != nil {
+		return baremetal.BMServerCreateRequest{}, err
 	}
 
 	blockStorageInfoList, err := ConvertBlockStorageList(blockStorageList, diskProductNameToId)
 	if err != nil {
-		return diag.FromErr(err)
+		return baremetal.BMServerCreateRequest{}, err
 	}
 
 	serverDetailList, err := ConvertBaremetalServerList(servers, blockStorageInfoList, scaleId)
 	if err != nil {
-		return diag.FromErr(err)
+		return baremetal.BMServerCreateRequest{}, err
 	}
 
-	createRequest := baremetal.BMServerCreateRequest{
+	return baremetal.BMServerCreateRequest{
 		BlockId:                   blockId,
 		ContractId:                contractId,
 		DeletionProtectionEnabled: isDeleteProtected,
@@ -522,25 +567,39 @@ func resourceBareMetalServerCreate(ctx context.Context, rd *schema.ResourceData,
 		ServiceZoneId:             vpcInfo.ServiceZoneId,
 		ServerDetails:             serverDetailList,
 		VpcId:                     vpcId,
-	}
+	}, nil
+}
 
-	createResponse, err := inst.Client.BareMetal.CreateBareMetalServer(ctx, createRequest, rd.Get("tags").(map[string]interface{}))
+// 이미지의 OS 에 맞는 admin 계정과 product group id 를 반환
+func getAdminAccountAndProductGroupId(ctx context.Context, rd *schema.ResourceData, meta interface{}, serviceZoneId string, imageId string, servers common.HclListObject) (string, string, error) {
+	adminAccount := rd.Get("admin_account").(string)
+
+	isOsWindows, targetProductGroupId, err := getImageInfo(ctx, serviceZoneId, imageId, meta)
 	if err != nil {
-		return
+		return "", "", err
 	}
 
-	resourceIds := strings.Split(createResponse.ResourceId, ",")
+	if !isOsWindows && adminAccount != common.LinuxAdminAccount {
+		adminAccount = common.LinuxAdminAccount
+		log.Println("Linux admin account must be root")
+	}
 
-	for _, resourceId := range resourceIds {
-		err = WaitForBMServerStatus(ctx, inst.Client, resourceId, common.VirtualServerProcessingStates(), []string{common.RunningState}, true)
+	if isOsWindows && (adminAccount == common.WindowsAdminAccount || len(adminAccount) < 5) {
+		return "", "", errors.New("Windows admin account must be 5 to 20 alpha-numeric characters with special character and not be 'administrator'.")
+	}
+
+	if len(targetProductGroupId) == 0 {
+		return "", "", errors.New("Product group id not found from image")
+	}
+
+	if isOsWindows {
+		err := common.ValidateServerNameInWindowImage(servers)
 		if err != nil {
-			return
+			return "", "", err
 		}
 	}
 
-	rd.SetId(createResponse.ResourceId)
-
-	return resourceBareMetalServerRead(ctx, rd, meta)
+	return adminAccount, targetProductGroupId, nil
 }
 
 func getImageInfo(ctx context.Context, serviceZoneId string, imageId string, meta interface{}) (bool, string, error) {
@@ -604,6 +663,7 @@ func resourceBareMetalServerRead(ctx context.Context, rd *schema.ResourceData, m
 	rd.Set("image_id", bmServerInfo.ImageId)
 	rd.Set("delete_protection", bmServerInfo.DeletionProtectionEnabled == "Y")
 	rd.Set("contract_discount", bmServerInfo.Contract)
+	common.SetContract(rd, bmServerInfo.Contract, bmServerInfo.NextContract, bmServerInfo.ContractEndDate)
 	rd.Set("vpc_id", bmServerInfo.VpcId)
 	rd.Set("initial_script", bmServerInfo.InitialScriptContent)
 
@@ -729,31 +789,217 @@ func resourceBareMetalServerUpdate(ctx context.Context, rd *schema.ResourceData,
 
 	inst := meta.(*client.Instance)
 
-	if !rd.HasChanges("delete_protection") && !rd.HasChanges("contract_discount") &&
-		!rd.HasChanges("block_storages") && !rd.HasChanges("servers") && !rd.HasChanges("tags") {
+	if !rd.HasChanges("delete_protection") && !rd.HasChanges("contract_discount", "contract", "next_contract") &&
+		!rd.HasChanges("block_storages") && !rd.HasChanges("servers") && !rd.HasChanges("tags") &&
+		!rd.HasChanges("image_id") && !rd.HasChanges("initial_script") {
 		return diag.Errorf("nothing to update")
 	}
 
 	serverIds := strings.Split(rd.Id(), ",")
 
-	/*
-		serverInfo, _, err := inst.Client.BareMetal.GetBareMetalServerDetail(ctx, serverIds[0])
+	if rd.HasChanges("delete_protection") {
+		isDeleteProtectionEnabled := "N"
+		if rd.Get("delete_protection").(bool) {
+			isDeleteProtectionEnabled = "Y"
+		}
+		for _, serverId := range serverIds {
+			_, err = inst.Client.BareMetal.ChangeBMDeletePolicy(ctx, serverId, isDeleteProtectionEnabled)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	o, n := rd.GetChange("servers")
+	oldServers := o.(common.HclListObject)
+	newServers := n.(common.HclListObject)
+
+	// 서버 이름으로 기존 서버 id 를 찾는다. (id 는 servers 순서대로 저장됨)
+	nameToId := make(map[string]string)
+	for i, itemObject := range oldServers {
+		if i < len(serverIds) {
+			nameToId[itemObject.(common.HclKeyValueObject)["bm_server_name"].(string)] = serverIds[i]
+		}
+	}
+
+	removedNames, addedNames := getServerNameDiff(oldServers, newServers)
+
+	// 제거된 서버만 삭제
+	if len(removedNames) != 0 {
+		removeIds := make([]string, 0)
+		for _, name := range removedNames {
+			removeIds = append(removeIds, nameToId[name])
+		}
+
+		for _, id := range removeIds {
+			err = WaitForBMServerStatus(ctx, inst.Client, id, common.VirtualServerProcessingStates(), []string{common.RunningState, common.StoppedState}, false)
+			if err != nil {
+				return
+			}
+		}
+
+		_, err = inst.Client.BareMetal.DeleteBareMetalServers(ctx, removeIds)
+		if err != nil && !common.IsDeleted(err) {
+			return
+		}
+
+		for _, id := range removeIds {
+			err = WaitForBMServerStatus(ctx, inst.Client, id, common.VirtualServerProcessingStates(), []string{common.DeletedState}, false)
+			if err != nil {
+				return
+			}
+		}
+
+		for _, name := range removedNames {
+			delete(nameToId, name)
+		}
+		setBareMetalServerIds(rd, newServers, nameToId)
+	}
+
+	// 남아있는 서버의 OS 재설치 (추가되는 서버는 새 이미지로 생성됨)
+	if rd.HasChanges("image_id") || rd.HasChanges("initial_script") {
+		if rd.Get("delete_protection").(bool) {
+			return diag.Errorf("OS reinstall is not allowed while delete_protection is enabled")
+		}
+
+		reinstallIds := make([]string, 0)
+		for _, itemObject := range newServers {
+			if id, ok := nameToId[itemObject.(common.HclKeyValueObject)["bm_server_name"].(string)]; ok {
+				reinstallIds = append(reinstallIds, id)
+			}
+		}
+
+		if len(reinstallIds) != 0 {
+			var serverInfo baremetal2.BareMetalServerDetailResponse
+			serverInfo, _, err = inst.Client.BareMetal.GetBareMetalServerDetail(ctx, reinstallIds[0])
+			if err != nil {
+				return
+			}
+
+			var adminAccount string
+			adminAccount, _, err = getAdminAccountAndProductGroupId(ctx, rd, meta, serverInfo.ServiceZoneId, rd.Get("image_id").(string), newServers)
+			if err != nil {
+				return
+			}
+
+			for _, id := range reinstallIds {
+				err = WaitForBMServerStatus(ctx, inst.Client, id, common.VirtualServerProcessingStates(), []string{common.RunningState, common.StoppedState}, true)
+				if err != nil {
+					return
+				}
+
+				_, err = inst.Client.BareMetal.ReinstallBareMetalServerOs(ctx, id, baremetal.BMOsReinstallRequest{
+					ImageId:        rd.Get("image_id").(string),
+					InitScript:     rd.Get("initial_script").(string),
+					OsUserId:       adminAccount,
+					OsUserPassword: rd.Get("admin_password").(string),
+				})
+				if err != nil {
+					return
+				}
+			}
+
+			// wait for server state change to editing
+			time.Sleep(3 * time.Second)
+
+			for _, id := range reinstallIds {
+				err = WaitForBMServerStatus(ctx, inst.Client, id, common.VirtualServerProcessingStates(), []string{common.RunningState}, true)
+				if err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	// 남아있는 서버의 약정 변경 (추가되는 서버는 새 약정으로 생성됨)
+	contractChanged := common.HasContractChange(rd, "contract") || common.HasContractChange(rd, "contract_discount")
+	nextContractChanged := common.HasContractChange(rd, "next_contract")
+	if (contractChanged || nextContractChanged) && len(nameToId) != 0 {
+		var serverInfo baremetal2.BareMetalServerDetailResponse
+		serverInfo, _, err = inst.Client.BareMetal.GetBareMetalServerDetail(ctx, serverIds[0])
 		if err != nil {
 			return
 		}
-		targetProductGroupId := serverInfo.ProductGroupId
 
-		if rd.HasChanges("delete_protection") {
-			deleteProtection := rd.Get("delete_protection").(bool)
-			isDeleteProtectionEnabled := "Y"
-			if !deleteProtection {
-				isDeleteProtectionEnabled = "N"
+		var productGroup product.ProductGroupDetailResponse
+		productGroup, err = inst.Client.Product.GetProductGroup(ctx, serverInfo.ProductGroupId)
+		if err != nil {
+			return
+		}
+
+		var contractId, nextContractId string
+		contractId, err = common.FindContractProductId(getBareMetalContract(rd), &productGroup)
+		if err != nil {
+			return
+		}
+		nextContractId, err = common.FindContractProductId(rd.Get("next_contract").(string), &productGroup)
+		if err != nil {
+			return
+		}
+
+		for _, id := range nameToId {
+			if contractChanged {
+				_, err = inst.Client.BareMetal.ChangeBMContract(ctx, id, contractId)
+				if err != nil {
+					return
+				}
 			}
-			_, err = inst.Client.BareMetal.ChangeBMDeletePolicy(ctx, rd.Id(), isDeleteProtectionEnabled)
+			if nextContractChanged {
+				_, err = inst.Client.BareMetal.ChangeBMNextContract(ctx, id, nextContractId)
+				if err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	// 추가된 서버만 생성
+	if len(addedNames) != 0 {
+		addedServers := common.HclListObject{}
+		for _, itemObject := range newServers {
+			name := itemObject.(common.HclKeyValueObject)["bm_server_name"].(string)
+			for _, addedName := range addedNames {
+				if name == addedName {
+					addedServers = append(addedServers, itemObject)
+				}
+			}
+		}
+
+		var createRequest baremetal.BMServerCreateRequest
+		createRequest, err = getBareMetalServerCreateRequest(ctx, rd, meta, addedServers)
+		if err != nil {
+			return
+		}
+
+		var createResponse baremetal2.AsyncResponse
+		createResponse, err = inst.Client.BareMetal.CreateBareMetalServer(ctx, createRequest, rd.Get("tags").(map[string]interface{}))
+		if err != nil {
+			return
+		}
+
+		for _, resourceId := range strings.Split(createResponse.ResourceId, ",") {
+			err = WaitForBMServerStatus(ctx, inst.Client, resourceId, common.VirtualServerProcessingStates(), []string{common.RunningState}, true)
 			if err != nil {
-				return diag.FromErr(err)
+				return
+			}
+
+			var serverInfo baremetal2.BareMetalServerDetailResponse
+			serverInfo, _, err = inst.Client.BareMetal.GetBareMetalServerDetail(ctx, resourceId)
+			if err != nil {
+				return
 			}
+			nameToId[serverInfo.BareMetalServerName] = resourceId
+		}
+
+		setBareMetalServerIds(rd, newServers, nameToId)
+	}
+
+	/*
+		serverInfo, _, err := inst.Client.BareMetal.GetBareMetalServerDetail(ctx, serverIds[0])
+		if err != nil {
+			return
 		}
+		targetProductGroupId := serverInfo.ProductGroupId
 
 		if rd.HasChanges("contract_discount") {
 			contractDiscount := rd.Get("contract_discount").(string)
@@ -835,19 +1081,29 @@ func resourceBareMetalServerUpdate(ctx context.Context, rd *schema.ResourceData,
 	*/
 
 	if rd.HasChanges("servers") {
-		idxList, newValue, err := getChangeDiffIndex(rd, "state")
-		if err != nil {
-			return diag.FromErr(err)
+		oldStates := make(map[string]string)
+		for _, itemObject := range oldServers {
+			item := itemObject.(common.HclKeyValueObject)
+			oldStates[item["bm_server_name"].(string)] = strings.ToUpper(item["state"].(string))
 		}
 
 		stopBaremetalIds := make([]string, 0)
 		startBaremetalIds := make([]string, 0)
 
-		for _, value := range idxList {
-			if strings.Compare(newValue[value], common.StoppedState) == 0 {
-				stopBaremetalIds = append(stopBaremetalIds, serverIds[value])
+		for _, itemObject := range newServers {
+			item := itemObject.(common.HclKeyValueObject)
+			name := item["bm_server_name"].(string)
+			newState := strings.ToUpper(item["state"].(string))
+
+			oldState, ok := oldStates[name]
+			if !ok || oldState == newState {
+				continue
+			}
+
+			if strings.Compare(newState, common.StoppedState) == 0 {
+				stopBaremetalIds = append(stopBaremetalIds, nameToId[name])
 			} else {
-				startBaremetalIds = append(startBaremetalIds, serverIds[value])
+				startBaremetalIds = append(startBaremetalIds, nameToId[name])
 			}
 		}
 
@@ -1000,7 +1256,7 @@ func resourceBareMetalServerUpdate(ctx context.Context, rd *schema.ResourceData,
 		}
 	*/
 
-	for _, bmId := range serverIds {
+	for _, bmId := range strings.Split(rd.Id(), ",") {
 		err = tfTags.UpdateTags(ctx, rd, meta, bmId)
 		if err != nil {
 			return
@@ -1010,6 +1266,31 @@ func resourceBareMetalServerUpdate(ctx context.Context, rd *schema.ResourceData,
 	return resourceBareMetalServerRead(ctx, rd, meta)
 }
 
+// contract 가 지정되지 않았으면 deprecated 된 contract_discount 를 사용한다.
+func getBareMetalContract(rd *schema.ResourceData) string {
+	if rd.HasChange("contract_discount") && !rd.HasChange("contract") {
+		return rd.Get("contract_discount").(string)
+	}
+	if contract := rd.Get("contract").(string); len(contract) != 0 {
+		return contract
+	}
+	if contract := rd.Get("contract_discount").(string); len(contract) != 0 {
+		return contract
+	}
+	return "None"
+}
+
+// servers 순서대로 서버 id 를 resource id 에 저장
+func setBareMetalServerIds(rd *schema.ResourceData, servers common.HclListObject, nameToId map[string]string) {
+	ids := make([]string, 0)
+	for _, itemObject := range servers {
+		if id, ok := nameToId[itemObject.(common.HclKeyValueObject)["bm_server_name"].(string)]; ok {
+			ids = append(ids, id)
+		}
+	}
+	rd.SetId(strings.Join(ids, ","))
+}
+
 func resourceBareMetalServerDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	inst := meta.(*client.Instance)
@@ -0,0 +1,308 @@
+package containerregistry
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/containerregistry"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	tfTags "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func init() {
+	samsungcloudplatform.RegisterResource("Container Registry", "samsungcloudplatform_container_registry", ResourceContainerRegistry())
+}
+
+func ResourceContainerRegistry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContainerRegistryCreate,
+		ReadContext:   resourceContainerRegistryRead,
+		UpdateContext: resourceContainerRegistryUpdate,
+		DeleteContext: resourceContainerRegistryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"registry_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: common.ValidateName3to20DashInMiddle,
+				Description:      "Container registry name",
+			},
+			"service_zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Service Zone Id",
+			},
+			"public_endpoint_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable public endpoint of the registry",
+			},
+			"allowed_ip_addresses": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
+				Description: "IP addresses (CIDR) allowed to access the public endpoint",
+			},
+			"repository": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Repository name",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Repository description. The description can not be updated, so changing it deletes and recreates the repository together with its images",
+						},
+						"keep_last_images_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Number of latest images to keep. 0 keeps every image",
+						},
+						"delete_untagged_after_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Days after which untagged images are deleted. 0 keeps untagged images",
+						},
+					},
+				},
+				Description: "Repositories of the registry managed by terraform. Repositories created outside terraform (e.g. by docker push) are not tracked",
+			},
+			"registry_endpoint": {Type: schema.TypeString, Computed: true, Description: "Registry endpoint used for docker login and image pull"},
+			"registry_state":    {Type: schema.TypeString, Computed: true, Description: "Registry state"},
+			"created_by":        {Type: schema.TypeString, Computed: true, Description: "The person who created the resource"},
+			"created_dt":        {Type: schema.TypeString, Computed: true, Description: "Creation time"},
+			"tags":              tfTags.TagsSchema(),
+		},
+		Description: "Provides a Container Registry resource with repositories, retention policies and access control.",
+	}
+}
+
+func resourceContainerRegistryCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	response, err := inst.Client.ContainerRegistry.CreateRegistry(ctx, containerregistry.CreateRegistryRequest{
+		RegistryName:          rd.Get("registry_name").(string),
+		ServiceZoneId:         rd.Get("service_zone_id").(string),
+		PublicEndpointEnabled: rd.Get("public_endpoint_enabled").(bool),
+		AllowedIpAddresses:    common.ToStringList(rd.Get("allowed_ip_addresses").(*schema.Set).List()),
+		Tags:                  rd.Get("tags").(map[string]interface{}),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(response.ResourceId)
+
+	err = client.WaitForStatus(ctx, inst.Client, []string{"CREATING"}, []string{"RUNNING"}, refreshContainerRegistry(ctx, meta, rd.Id(), true))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateRepositories(ctx, rd, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceContainerRegistryRead(ctx, rd, meta)
+}
+
+func resourceContainerRegistryRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	info, _, err := inst.Client.ContainerRegistry.GetRegistry(ctx, rd.Id())
+	if err != nil {
+		rd.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	repositories, err := inst.Client.ContainerRegistry.GetRepositoryList(ctx, rd.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// 설정에 선언된 repository 만 state 에 반영한다. (docker push 로 생긴 repository 는 관리하지 않는다)
+	declaredRepositories := toRepositoryMap(rd.Get("repository").(*schema.Set))
+	repositoryList := make([]map[string]interface{}, 0)
+	for _, repository := range repositories.Contents {
+		if _, ok := declaredRepositories[repository.RepositoryName]; !ok {
+			continue
+		}
+		repositoryList = append(repositoryList, map[string]interface{}{
+			"name":                       repository.RepositoryName,
+			"description":                repository.Description,
+			"keep_last_images_count":     repository.KeepLastImagesCount,
+			"delete_untagged_after_days": repository.DeleteUntaggedAfterDays,
+		})
+	}
+
+	rd.Set("registry_name", info.RegistryName)
+	rd.Set("service_zone_id", info.ServiceZoneId)
+	rd.Set("public_endpoint_enabled", info.PublicEndpointEnabled)
+	rd.Set("allowed_ip_addresses", info.AllowedIpAddresses)
+	rd.Set("repository", repositoryList)
+	rd.Set("registry_endpoint", info.RegistryEndpoint)
+	rd.Set("registry_state", info.RegistryState)
+	rd.Set("created_by", info.CreatedBy)
+	rd.Set("created_dt", info.CreatedDt.String())
+	tfTags.SetTags(ctx, rd, meta, rd.Id())
+
+	return nil
+}
+
+func resourceContainerRegistryUpdate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	if rd.HasChanges("public_endpoint_enabled", "allowed_ip_addresses") {
+		_, err := inst.Client.ContainerRegistry.UpdateRegistryAccessControl(ctx, rd.Id(), containerregistry.UpdateRegistryAccessControlRequest{
+			PublicEndpointEnabled: rd.Get("public_endpoint_enabled").(bool),
+			AllowedIpAddresses:    common.ToStringList(rd.Get("allowed_ip_addresses").(*schema.Set).List()),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = client.WaitForStatus(ctx, inst.Client, []string{"EDITING"}, []string{"RUNNING"}, refreshContainerRegistry(ctx, meta, rd.Id(), true))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if rd.HasChanges("repository") {
+		err := updateRepositories(ctx, rd, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := tfTags.UpdateTags(ctx, rd, meta, rd.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceContainerRegistryRead(ctx, rd, meta)
+}
+
+func resourceContainerRegistryDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	_, err := inst.Client.ContainerRegistry.DeleteRegistry(ctx, rd.Id())
+	if err != nil && !common.IsDeleted(err) {
+		return diag.FromErr(err)
+	}
+
+	err = client.WaitForStatus(ctx, inst.Client, []string{"DELETING"}, []string{"DELETED"}, refreshContainerRegistry(ctx, meta, rd.Id(), false))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// repository 는 이름 기준으로 비교해서 추가 / 삭제하고, 이름이 같으면 retention 정책만 수정한다.
+// description 은 수정 API 가 없으므로 바뀌면 repository 를 삭제 후 다시 만든다.
+func updateRepositories(ctx context.Context, rd *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	o, n := rd.GetChange("repository")
+	oldRepositories := toRepositoryMap(o.(*schema.Set))
+	newRepositories := toRepositoryMap(n.(*schema.Set))
+
+	for name := range oldRepositories {
+		if _, ok := newRepositories[name]; ok {
+			continue
+		}
+		_, err := inst.Client.ContainerRegistry.DeleteRepository(ctx, rd.Id(), name)
+		if err != nil && !common.IsDeleted(err) {
+			return err
+		}
+	}
+
+	for name, repository := range newRepositories {
+		oldRepository, exists := oldRepositories[name]
+		if exists && oldRepository["description"] != repository["description"] {
+			log.Printf("[INFO] Container registry(%s) repository %s is recreated to change its description", rd.Id(), name)
+			_, err := inst.Client.ContainerRegistry.DeleteRepository(ctx, rd.Id(), name)
+			if err != nil && !common.IsDeleted(err) {
+				return err
+			}
+			exists = false
+		}
+
+		if !exists {
+			_, err := inst.Client.ContainerRegistry.CreateRepository(ctx, rd.Id(), containerregistry.CreateRepositoryRequest{
+				RepositoryName: name,
+				Description:    repository["description"].(string),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if exists && oldRepository["keep_last_images_count"] == repository["keep_last_images_count"] &&
+			oldRepository["delete_untagged_after_days"] == repository["delete_untagged_after_days"] {
+			continue
+		}
+
+		_, err := inst.Client.ContainerRegistry.UpdateRetentionPolicy(ctx, rd.Id(), name, containerregistry.UpdateRetentionPolicyRequest{
+			KeepLastImagesCount:     int32(repository["keep_last_images_count"].(int)),
+			DeleteUntaggedAfterDays: int32(repository["delete_untagged_after_days"].(int)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toRepositoryMap(set *schema.Set) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+	for _, v := range set.List() {
+		repository := v.(map[string]interface{})
+		result[repository["name"].(string)] = repository
+	}
+	return result
+}
+
+func refreshContainerRegistry(ctx context.Context, meta interface{}, id string, errorOnNotFound bool) func() (interface{}, string, error) {
+	inst := meta.(*client.Instance)
+
+	return func() (interface{}, string, error) {
+		info, httpStatus, err := inst.Client.ContainerRegistry.GetRegistry(ctx, id)
+
+		if httpStatus == 200 {
+			return info, info.RegistryState, nil
+		} else if httpStatus == 404 {
+			if errorOnNotFound {
+				return nil, "", fmt.Errorf("container registry with id=%s not found", id)
+			}
+
+			return info, "DELETED", nil
+		} else if err != nil {
+			return nil, "", err
+		}
+
+		return nil, "", fmt.Errorf("failed to read container registry(%s) status:%d", id, httpStatus)
+	}
+}
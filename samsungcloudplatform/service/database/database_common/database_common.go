@@ -5,6 +5,9 @@ import (
 	"reflect"
 	"strings"
 	time "time"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type HclKeyValueObject = map[string]interface{}
@@ -118,3 +121,26 @@ func SnakeToCamel(s string) string {
 	}
 	return strings.Join(parts, "")
 }
+
+// contract, next_contract 순서로 변경 사항을 반영하고, 각 변경 후 cluster 가 다시 Running 이 될 때까지 기다린다.
+func UpdateContract(rd *schema.ResourceData, modifyContract func(contract string) error, modifyNextContract func(nextContract string) error, wait func() error) error {
+	if common.HasContractChange(rd, "contract") {
+		if err := modifyContract(rd.Get("contract").(string)); err != nil {
+			return err
+		}
+		if err := wait(); err != nil {
+			return err
+		}
+	}
+
+	if common.HasContractChange(rd, "next_contract") {
+		if err := modifyNextContract(rd.Get("next_contract").(string)); err != nil {
+			return err
+		}
+		if err := wait(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
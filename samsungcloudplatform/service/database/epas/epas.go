@@ -251,7 +251,10 @@ func ResourceEpas() *schema.Resource {
 				Computed:    true,
 				Description: "vpc id",
 			},
-			"tags": tfTags.TagsSchema(),
+			"contract":          common.ContractSchema(),
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
+			"tags":              tfTags.TagsSchema(),
 		},
 		Description: "Provides a EPAS Database resource.",
 	}
@@ -412,6 +415,15 @@ func resourceEpasCreate(ctx context.Context, rd *schema.ResourceData, meta inter
 		}
 	}
 
+	err = updateEpasClusterContract(UpdateEpasParam{
+		Ctx:  ctx,
+		Rd:   rd,
+		Inst: inst,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if epasClusterState == common.StoppedState {
 		err := stopEpasCluster(UpdateEpasParam{
 			Ctx:  ctx,
@@ -590,6 +602,13 @@ func resourceEpasRead(ctx context.Context, rd *schema.ResourceData, meta interfa
 		return diag.FromErr(err)
 	}
 
+	if dbInfo.Contract != nil {
+		err = common.SetContract(rd, dbInfo.Contract.ContractPeriod, dbInfo.Contract.NextContractPeriod, dbInfo.Contract.ContractEndDate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = tfTags.SetTags(ctx, rd, meta, rd.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -649,6 +668,9 @@ func resourceEpasUpdate(ctx context.Context, rd *schema.ResourceData, meta inter
 	if rd.HasChanges("backup") {
 		updateFuncs = append(updateFuncs, updateBackup)
 	}
+	if rd.HasChanges("contract", "next_contract") {
+		updateFuncs = append(updateFuncs, updateEpasClusterContract)
+	}
 
 	for _, f := range updateFuncs {
 		err = f(param)
@@ -691,6 +713,8 @@ func resourceEpasDiff(ctx context.Context, rd *schema.ResourceDiff, meta interfa
 		"security_group_ids",
 		"epas_cluster_state",
 		"backup",
+		"contract",
+		"next_contract",
 		"tags",
 	}
 	resourceEpas := ResourceEpas().Schema
@@ -1011,6 +1035,25 @@ func deleteEpasClusterFullBackupConfig(param UpdateEpasParam) error {
 
 }
 
+func updateEpasClusterContract(param UpdateEpasParam) error {
+	return database_common.UpdateContract(param.Rd,
+		func(contract string) error {
+			_, _, err := param.Inst.Client.Epas.ModifyEpasClusterContract(param.Ctx, param.Rd.Id(), epas.DbClusterModifyContractRequest{
+				ContractPeriod: contract,
+			})
+			return err
+		},
+		func(nextContract string) error {
+			_, _, err := param.Inst.Client.Epas.ModifyEpasClusterNextContract(param.Ctx, param.Rd.Id(), epas.DbClusterModifyNextContractRequest{
+				NextContractPeriod: nextContract,
+			})
+			return err
+		},
+		func() error {
+			return waitForEpas(param.Ctx, param.Inst.Client, param.Rd.Id(), common.DatabaseProcessingStates(), []string{common.RunningState}, true)
+		})
+}
+
 func waitForEpas(ctx context.Context, scpClient *client.SCPClient, epasClusterId string, pendingStates []string, targetStates []string, errorOnNotFound bool) error {
 	return client.WaitForStatus(ctx, scpClient, pendingStates, targetStates, func() (interface{}, string, error) {
 		var info epas.EpasClusterDetailResponse
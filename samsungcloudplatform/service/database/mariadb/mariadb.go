@@ -245,7 +245,10 @@ func ResourceMariadb() *schema.Resource {
 				Computed:    true,
 				Description: "vpc id",
 			},
-			"tags": tfTags.TagsSchema(),
+			"contract":          common.ContractSchema(),
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
+			"tags":              tfTags.TagsSchema(),
 		},
 		Description: "Provides a Mariadb Database resource.",
 	}
@@ -404,6 +407,15 @@ func resourceMariadbCreate(ctx context.Context, rd *schema.ResourceData, meta in
 		}
 	}
 
+	err = updateMariadbClusterContract(UpdateMariadbParam{
+		Ctx:  ctx,
+		Rd:   rd,
+		Inst: inst,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if mariadbClusterState == common.StoppedState {
 		err := stopMariadbCluster(UpdateMariadbParam{
 			Ctx:  ctx,
@@ -578,6 +590,13 @@ func resourceMariadbRead(ctx context.Context, rd *schema.ResourceData, meta inte
 		return diag.FromErr(err)
 	}
 
+	if dbInfo.Contract != nil {
+		err = common.SetContract(rd, dbInfo.Contract.ContractPeriod, dbInfo.Contract.NextContractPeriod, dbInfo.Contract.ContractEndDate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = tfTags.SetTags(ctx, rd, meta, rd.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -637,6 +656,9 @@ func resourceMariadbUpdate(ctx context.Context, rd *schema.ResourceData, meta in
 	if rd.HasChanges("backup") {
 		updateFuncs = append(updateFuncs, updateBackup)
 	}
+	if rd.HasChanges("contract", "next_contract") {
+		updateFuncs = append(updateFuncs, updateMariadbClusterContract)
+	}
 
 	for _, f := range updateFuncs {
 		err = f(param)
@@ -679,6 +701,8 @@ func resourceMariadbDiff(ctx context.Context, rd *schema.ResourceDiff, meta inte
 		"security_group_ids",
 		"mariadb_cluster_state",
 		"backup",
+		"contract",
+		"next_contract",
 		"tags",
 	}
 	resourceMariadb := ResourceMariadb().Schema
@@ -999,6 +1023,25 @@ func deleteMariadbClusterFullBackupConfig(param UpdateMariadbParam) error {
 
 }
 
+func updateMariadbClusterContract(param UpdateMariadbParam) error {
+	return database_common.UpdateContract(param.Rd,
+		func(contract string) error {
+			_, _, err := param.Inst.Client.Mariadb.ModifyMariadbClusterContract(param.Ctx, param.Rd.Id(), mariadb.DbClusterModifyContractRequest{
+				ContractPeriod: contract,
+			})
+			return err
+		},
+		func(nextContract string) error {
+			_, _, err := param.Inst.Client.Mariadb.ModifyMariadbClusterNextContract(param.Ctx, param.Rd.Id(), mariadb.DbClusterModifyNextContractRequest{
+				NextContractPeriod: nextContract,
+			})
+			return err
+		},
+		func() error {
+			return waitForMariadb(param.Ctx, param.Inst.Client, param.Rd.Id(), common.DatabaseProcessingStates(), []string{common.RunningState}, true)
+		})
+}
+
 func waitForMariadb(ctx context.Context, scpClient *client.SCPClient, MariadbClusterId string, pendingStates []string, targetStates []string, errorOnNotFound bool) error {
 	return client.WaitForStatus(ctx, scpClient, pendingStates, targetStates, func() (interface{}, string, error) {
 		var info mariadb.MariadbClusterDetailResponse
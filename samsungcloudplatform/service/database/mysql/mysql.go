@@ -245,7 +245,10 @@ func ResourceMysql() *schema.Resource {
 				Computed:    true,
 				Description: "vpc id",
 			},
-			"tags": tfTags.TagsSchema(),
+			"contract":          common.ContractSchema(),
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
+			"tags":              tfTags.TagsSchema(),
 		},
 		Description: "Provides a Mysql Database resource.",
 	}
@@ -404,6 +407,15 @@ func resourceMysqlCreate(ctx context.Context, rd *schema.ResourceData, meta inte
 		}
 	}
 
+	err = updateMysqlClusterContract(UpdateMysqlParam{
+		Ctx:  ctx,
+		Rd:   rd,
+		Inst: inst,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if mysqlClusterState == common.StoppedState {
 		err := stopMysqlCluster(UpdateMysqlParam{
 			Ctx:  ctx,
@@ -577,6 +589,13 @@ func resourceMysqlRead(ctx context.Context, rd *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 
+	if dbInfo.Contract != nil {
+		err = common.SetContract(rd, dbInfo.Contract.ContractPeriod, dbInfo.Contract.NextContractPeriod, dbInfo.Contract.ContractEndDate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = tfTags.SetTags(ctx, rd, meta, rd.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -636,6 +655,9 @@ func resourceMysqlUpdate(ctx context.Context, rd *schema.ResourceData, meta inte
 	if rd.HasChanges("backup") {
 		updateFuncs = append(updateFuncs, updateBackup)
 	}
+	if rd.HasChanges("contract", "next_contract") {
+		updateFuncs = append(updateFuncs, updateMysqlClusterContract)
+	}
 
 	for _, f := range updateFuncs {
 		err = f(param)
@@ -678,6 +700,8 @@ func resourceMysqlDiff(ctx context.Context, rd *schema.ResourceDiff, meta interf
 		"security_group_ids",
 		"mysql_cluster_state",
 		"backup",
+		"contract",
+		"next_contract",
 		"tags",
 	}
 	resourceMysql := ResourceMysql().Schema
@@ -998,6 +1022,25 @@ func deleteMysqlClusterFullBackupConfig(param UpdateMysqlParam) error {
 
 }
 
+func updateMysqlClusterContract(param UpdateMysqlParam) error {
+	return database_common.UpdateContract(param.Rd,
+		func(contract string) error {
+			_, _, err := param.Inst.Client.Mysql.ModifyMysqlClusterContract(param.Ctx, param.Rd.Id(), mysql.DbClusterModifyContractRequest{
+				ContractPeriod: contract,
+			})
+			return err
+		},
+		func(nextContract string) error {
+			_, _, err := param.Inst.Client.Mysql.ModifyMysqlClusterNextContract(param.Ctx, param.Rd.Id(), mysql.DbClusterModifyNextContractRequest{
+				NextContractPeriod: nextContract,
+			})
+			return err
+		},
+		func() error {
+			return waitForMysql(param.Ctx, param.Inst.Client, param.Rd.Id(), common.DatabaseProcessingStates(), []string{common.RunningState}, true)
+		})
+}
+
 func waitForMysql(ctx context.Context, scpClient *client.SCPClient, MysqlClusterId string, pendingStates []string, targetStates []string, errorOnNotFound bool) error {
 	return client.WaitForStatus(ctx, scpClient, pendingStates, targetStates, func() (interface{}, string, error) {
 		var info mysql.MysqlClusterDetailResponse
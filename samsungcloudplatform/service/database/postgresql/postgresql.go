@@ -251,7 +251,10 @@ func ResourcePostgresql() *schema.Resource {
 				Computed:    true,
 				Description: "vpc id",
 			},
-			"tags": tfTags.TagsSchema(),
+			"contract":          common.ContractSchema(),
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
+			"tags":              tfTags.TagsSchema(),
 		},
 		Description: "Provides a PostgreSQL Database resource.",
 	}
@@ -412,6 +415,15 @@ func resourcePostgresqlCreate(ctx context.Context, rd *schema.ResourceData, meta
 		}
 	}
 
+	err = updatePostgresqlClusterContract(UpdatePostgresqlParam{
+		Ctx:  ctx,
+		Rd:   rd,
+		Inst: inst,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if postgresqlClusterState == common.StoppedState {
 		err := stopPostgresqlCluster(UpdatePostgresqlParam{
 			Ctx:  ctx,
@@ -590,6 +602,13 @@ func resourcePostgresqlRead(ctx context.Context, rd *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 
+	if dbInfo.Contract != nil {
+		err = common.SetContract(rd, dbInfo.Contract.ContractPeriod, dbInfo.Contract.NextContractPeriod, dbInfo.Contract.ContractEndDate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = tfTags.SetTags(ctx, rd, meta, rd.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -649,6 +668,9 @@ func resourcePostgresqlUpdate(ctx context.Context, rd *schema.ResourceData, meta
 	if rd.HasChanges("backup") {
 		updateFuncs = append(updateFuncs, updateBackup)
 	}
+	if rd.HasChanges("contract", "next_contract") {
+		updateFuncs = append(updateFuncs, updatePostgresqlClusterContract)
+	}
 
 	for _, f := range updateFuncs {
 		err = f(param)
@@ -691,6 +713,8 @@ func resourcePostgresqlDiff(ctx context.Context, rd *schema.ResourceDiff, meta i
 		"security_group_ids",
 		"postgresql_cluster_state",
 		"backup",
+		"contract",
+		"next_contract",
 		"tags",
 	}
 	resourcePostgresql := ResourcePostgresql().Schema
@@ -1011,6 +1035,25 @@ func deletePostgresqlClusterFullBackupConfig(param UpdatePostgresqlParam) error
 
 }
 
+func updatePostgresqlClusterContract(param UpdatePostgresqlParam) error {
+	return database_common.UpdateContract(param.Rd,
+		func(contract string) error {
+			_, _, err := param.Inst.Client.Postgresql.ModifyPostgresqlClusterContract(param.Ctx, param.Rd.Id(), postgresql.DbClusterModifyContractRequest{
+				ContractPeriod: contract,
+			})
+			return err
+		},
+		func(nextContract string) error {
+			_, _, err := param.Inst.Client.Postgresql.ModifyPostgresqlClusterNextContract(param.Ctx, param.Rd.Id(), postgresql.DbClusterModifyNextContractRequest{
+				NextContractPeriod: nextContract,
+			})
+			return err
+		},
+		func() error {
+			return waitForPostgresql(param.Ctx, param.Inst.Client, param.Rd.Id(), common.DatabaseProcessingStates(), []string{common.RunningState}, true)
+		})
+}
+
 func waitForPostgresql(ctx context.Context, scpClient *client.SCPClient, postgresqlClusterId string, pendingStates []string, targetStates []string, errorOnNotFound bool) error {
 	return client.WaitForStatus(ctx, scpClient, pendingStates, targetStates, func() (interface{}, string, error) {
 		var info postgresql.PostgresqlClusterDetailResponse
@@ -39,7 +39,10 @@ func ResourceRedis() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
-			"tags": tfTags.TagsSchema(),
+			"contract":          common.ContractSchema(),
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
+			"tags":              tfTags.TagsSchema(),
 			"redis_name": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -430,6 +433,15 @@ func resourceRedisCreate(ctx context.Context, rd *schema.ResourceData, meta inte
 		}
 	}
 
+	err = updateRedisContract(UpdateRedisParam{
+		Ctx:  ctx,
+		Rd:   rd,
+		Inst: inst,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if redisState == common.StoppedState {
 		err := stopRedis(UpdateRedisParam{
 			Ctx:  ctx,
@@ -590,6 +602,13 @@ func resourceRedisRead(ctx context.Context, rd *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 
+	if dbInfo.Contract != nil {
+		err = common.SetContract(rd, dbInfo.Contract.ContractPeriod, dbInfo.Contract.NextContractPeriod, dbInfo.Contract.ContractEndDate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = tfTags.SetTags(ctx, rd, meta, rd.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -646,6 +665,9 @@ func resourceRedisUpdate(ctx context.Context, rd *schema.ResourceData, meta inte
 	if rd.HasChanges("backup") {
 		updateFuncs = append(updateFuncs, updateBackup)
 	}
+	if rd.HasChanges("contract", "next_contract") {
+		updateFuncs = append(updateFuncs, updateRedisContract)
+	}
 
 	for _, f := range updateFuncs {
 		err = f(param)
@@ -697,6 +719,8 @@ func resourceRedisDiff(ctx context.Context, rd *schema.ResourceDiff, meta interf
 		"backup",
 		"redis_servers",
 		"redis_sentinel_server",
+		"contract",
+		"next_contract",
 		"tags",
 	}
 	resourceRedis := ResourceRedis().Schema
@@ -990,6 +1014,25 @@ func deleteRedisFullBackupConfig(param UpdateRedisParam) error {
 
 }
 
+func updateRedisContract(param UpdateRedisParam) error {
+	return database_common.UpdateContract(param.Rd,
+		func(contract string) error {
+			_, _, err := param.Inst.Client.Redis.ModifyRedisContract(param.Ctx, param.Rd.Id(), redis.DbClusterModifyContractRequest{
+				ContractPeriod: contract,
+			})
+			return err
+		},
+		func(nextContract string) error {
+			_, _, err := param.Inst.Client.Redis.ModifyRedisNextContract(param.Ctx, param.Rd.Id(), redis.DbClusterModifyNextContractRequest{
+				NextContractPeriod: nextContract,
+			})
+			return err
+		},
+		func() error {
+			return waitForRedis(param.Ctx, param.Inst.Client, param.Rd.Id(), common.DatabaseProcessingStates(), []string{common.RunningState}, true)
+		})
+}
+
 func waitForRedis(ctx context.Context, scpClient *client.SCPClient, redisId string, pendingStates []string, targetStates []string, errorOnNotFound bool) error {
 	return client.WaitForStatus(ctx, scpClient, pendingStates, targetStates, func() (interface{}, string, error) {
 		var info redis.RedisDetailResponse
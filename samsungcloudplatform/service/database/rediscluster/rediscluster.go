@@ -205,7 +205,10 @@ func ResourceRedisCluster() *schema.Resource {
 				Computed:    true,
 				Description: "vpc id",
 			},
-			"tags": tfTags.TagsSchema(),
+			"contract":          common.ContractSchema(),
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
+			"tags":              tfTags.TagsSchema(),
 		},
 	}
 
@@ -384,6 +387,15 @@ func resourceRedisClusterCreate(ctx context.Context, rd *schema.ResourceData, me
 		}
 	}
 
+	err = updateRedisClusterContract(UpdateRedisClusterParam{
+		Ctx:  ctx,
+		Rd:   rd,
+		Inst: inst,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if redisClusterState == common.StoppedState {
 		err := stopRedisCluster(UpdateRedisClusterParam{
 			Ctx:  ctx,
@@ -555,6 +567,13 @@ func resourceRedisClusterRead(ctx context.Context, rd *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if dbInfo.Contract != nil {
+		err = common.SetContract(rd, dbInfo.Contract.ContractPeriod, dbInfo.Contract.NextContractPeriod, dbInfo.Contract.ContractEndDate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = tfTags.SetTags(ctx, rd, meta, rd.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -614,6 +633,9 @@ func resourceRedisClusterUpdate(ctx context.Context, rd *schema.ResourceData, me
 	if rd.HasChanges("backup") {
 		updateFuncs = append(updateFuncs, updateBackup)
 	}
+	if rd.HasChanges("contract", "next_contract") {
+		updateFuncs = append(updateFuncs, updateRedisClusterContract)
+	}
 
 	for _, f := range updateFuncs {
 		err = f(param)
@@ -656,6 +678,8 @@ func resourceRedisClusterDiff(ctx context.Context, rd *schema.ResourceDiff, meta
 		"security_group_ids",
 		"redis_cluster_state",
 		"backup",
+		"contract",
+		"next_contract",
 		"tags",
 		"redis_servers",
 	}
@@ -944,6 +968,25 @@ func deleteRedisClusterFullBackupConfig(param UpdateRedisClusterParam) error {
 	return nil
 
 }
+func updateRedisClusterContract(param UpdateRedisClusterParam) error {
+	return database_common.UpdateContract(param.Rd,
+		func(contract string) error {
+			_, _, err := param.Inst.Client.RedisCluster.ModifyRedisClusterContract(param.Ctx, param.Rd.Id(), redis.DbClusterModifyContractRequest{
+				ContractPeriod: contract,
+			})
+			return err
+		},
+		func(nextContract string) error {
+			_, _, err := param.Inst.Client.RedisCluster.ModifyRedisClusterNextContract(param.Ctx, param.Rd.Id(), redis.DbClusterModifyNextContractRequest{
+				NextContractPeriod: nextContract,
+			})
+			return err
+		},
+		func() error {
+			return waitForRedisCluster(param.Ctx, param.Inst.Client, param.Rd.Id(), common.DatabaseProcessingStates(), []string{common.RunningState}, true)
+		})
+}
+
 func waitForRedisCluster(ctx context.Context, scpClient *client.SCPClient, redisId string, pendingStates []string, targetStates []string, errorOnNotFound bool) error {
 	return client.WaitForStatus(ctx, scpClient, pendingStates, targetStates, func() (interface{}, string, error) {
 		var info redis.RedisClusterDetailResponse
@@ -304,7 +304,10 @@ func ResourceSqlserver() *schema.Resource {
 				Computed:    true,
 				Description: "vpc id",
 			},
-			"tags": tfTags.TagsSchema(),
+			"contract":          common.ContractSchema(),
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
+			"tags":              tfTags.TagsSchema(),
 		},
 		Description: "Provide Microsoft SQL Server resource.",
 	}
@@ -484,6 +487,15 @@ func resourceSqlserverCreate(ctx context.Context, rd *schema.ResourceData, meta
 		}
 	}
 
+	err = updateSqlserverClusterContract(UpdateSqlserverParam{
+		Ctx:  ctx,
+		Rd:   rd,
+		Inst: inst,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if sqlserverClusterState == common.StoppedState {
 		err := stopSqlserverCluster(UpdateSqlserverParam{
 			Ctx:  ctx,
@@ -656,6 +668,13 @@ func resourceSqlserverRead(ctx context.Context, rd *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
+	if sqlserverClusterDetail.Contract != nil {
+		err = common.SetContract(rd, sqlserverClusterDetail.Contract.ContractPeriod, sqlserverClusterDetail.Contract.NextContractPeriod, sqlserverClusterDetail.Contract.ContractEndDate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = tfTags.SetTags(ctx, rd, meta, rd.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -712,6 +731,9 @@ func resourceSqlserverUpdate(ctx context.Context, rd *schema.ResourceData, meta
 	if rd.HasChanges("backup") {
 		updateFuncs = append(updateFuncs, updateBackup)
 	}
+	if rd.HasChanges("contract", "next_contract") {
+		updateFuncs = append(updateFuncs, updateSqlserverClusterContract)
+	}
 
 	for _, f := range updateFuncs {
 		err = f(param)
@@ -1021,6 +1043,25 @@ func resourceSqlserverDelete(ctx context.Context, rd *schema.ResourceData, meta
 	return nil
 }
 
+func updateSqlserverClusterContract(param UpdateSqlserverParam) error {
+	return database_common.UpdateContract(param.Rd,
+		func(contract string) error {
+			_, _, err := param.Inst.Client.Sqlserver.ModifySqlserverClusterContract(param.Ctx, param.Rd.Id(), sqlserver.DbClusterModifyContractRequest{
+				ContractPeriod: contract,
+			})
+			return err
+		},
+		func(nextContract string) error {
+			_, _, err := param.Inst.Client.Sqlserver.ModifySqlserverClusterNextContract(param.Ctx, param.Rd.Id(), sqlserver.DbClusterModifyNextContractRequest{
+				NextContractPeriod: nextContract,
+			})
+			return err
+		},
+		func() error {
+			return waitForSqlserver(param.Ctx, param.Inst.Client, param.Rd.Id(), common.DatabaseProcessingStates(), []string{common.RunningState}, true)
+		})
+}
+
 func waitForSqlserver(ctx context.Context, scpClient *client.SCPClient, sqlserverClusterId string, pendingStates []string, targetStates []string, errorOnNotFound bool) error {
 	return client.WaitForStatus(ctx, scpClient, pendingStates, targetStates, func() (interface{}, string, error) {
 		var info sqlserver.SqlserverClusterDetailResponse
@@ -1079,6 +1120,8 @@ func resourceSqlserverDiff(ctx context.Context, rd *schema.ResourceDiff, meta in
 		"security_group_ids",
 		"sqlserver_cluster_state",
 		"backup",
+		"contract",
+		"next_contract",
 		"tags",
 	}
 	resourceSqlserver := ResourceSqlserver().Schema
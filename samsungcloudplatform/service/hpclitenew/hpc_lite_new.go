@@ -11,9 +11,11 @@ import (
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/hpclitenew"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
 	tfTags "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/tag"
+	hpclitenew2 "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/hpc-lite-new"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"golang.org/x/net/context"
 )
 
@@ -47,6 +49,8 @@ func ResourceHpcLiteNew() *schema.Resource {
 				Required:    true,
 				Description: "HPC Lite(New) Contract",
 			},
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
 			"hyper_threading_enabled": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -101,8 +105,9 @@ func ResourceHpcLiteNew() *schema.Resource {
 				Description: "HPC Lite(New) Vlan Pool CIDR",
 			},
 			"server_details": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "HPC Lite(New) Server Details. Servers are identified by server_name, so added or removed entries do not affect the other servers.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -121,6 +126,13 @@ func ResourceHpcLiteNew() *schema.Resource {
 							Optional:    true,
 							Description: "HPC Lite(New) Server Detail ip address",
 						},
+						"state": {
+							Type:             schema.TypeString,
+							Computed:         true,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{common.RunningState, common.StoppedState}, false)),
+							Description:      "HPC Lite(New) Server state (RUNNING, STOPPED)",
+						},
 					},
 				},
 			},
@@ -135,9 +147,6 @@ func ResourceHpcLiteNew() *schema.Resource {
 				if diff.HasChange("co_service_zone_id") {
 					return fmt.Errorf("co_service_zone_id can't be modified.")
 				}
-				if diff.HasChange("contract") {
-					return fmt.Errorf("contract can't be modified.")
-				}
 				if diff.HasChange("hyper_threading_enabled") {
 					return fmt.Errorf("hyper_threading_enabled can't be modified.")
 				}
@@ -196,8 +205,13 @@ func resourceHpcLiteNewCreate(ctx context.Context, rd *schema.ResourceData, meta
 	}()
 	inst := meta.(*client.Instance)
 
+	serverDetails := rd.Get("server_details").([]interface{})
+	if _, hasDuplicatedName := mapServerNameToDetail(serverDetails); hasDuplicatedName {
+		return diag.Errorf("Server Name is duplicated.")
+	}
+
 	var serverDetailsRequestList []hpclitenew.ServerDetailRequest
-	for _, server := range rd.Get("server_details").([]interface{}) {
+	for _, server := range serverDetails {
 		serverDetail := server.(map[string]interface{})
 		serverDetailsRequestList = append(serverDetailsRequestList, hpclitenew.ServerDetailRequest{
 			ServerName: serverDetail["server_name"].(string),
@@ -205,36 +219,23 @@ func resourceHpcLiteNewCreate(ctx context.Context, rd *schema.ResourceData, meta
 		})
 	}
 
-	request := hpclitenew.HpcLiteNewCreateRequest{
-		CoServiceZoneId:       rd.Get("co_service_zone_id").(string),
-		Contract:              rd.Get("contract").(string),
-		HyperThreadingEnabled: rd.Get("hyper_threading_enabled").(string),
-		ImageId:               rd.Get("image_id").(string),
-		InitScript:            rd.Get("init_script").(string),
-		OsUserId:              rd.Get("os_user_id").(string),
-		OsUserPassword:        rd.Get("os_user_password").(string),
-		ProductGroupId:        rd.Get("product_group_id").(string),
-		ResourcePoolId:        rd.Get("resource_pool_id").(string),
-		ServerDetails:         serverDetailsRequestList,
-		ServerType:            rd.Get("server_type").(string),
-		ServiceZoneId:         rd.Get("service_zone_id").(string),
-		Tags:                  rd.Get("tags").(map[string]interface{}),
-		VlanPoolCidr:          rd.Get("vlan_pool_cidr").(string),
-	}
-
-	response, _, err := inst.Client.HpcLiteNew.CreateHpcLiteNew(ctx, request)
+	nameToServerId, err := createHpcLiteNewServers(ctx, rd, serverDetailsRequestList, inst)
 	if err != nil {
-		diag.FromErr(err)
+		return
 	}
+	setResourceIdByServerDetails(rd, serverDetails, nameToServerId)
 
-	for _, serverId := range response.ResourceIdList {
-		err = waitForAllHpcLiteNewStatus(ctx, inst.Client, serverId, []string{common.CreatingState}, []string{common.RunningState}, true)
+	if common.HasContractChange(rd, "next_contract") {
+		err = updateHpcLiteNewContract(ctx, rd, getServerIds(rd), inst)
 		if err != nil {
-			diag.FromErr(err)
+			return
 		}
 	}
 
-	setResourceId(rd, response.ResourceIdList)
+	err = updateHpcLiteNewServerStates(ctx, rd, serverDetails, nameToServerId, inst)
+	if err != nil {
+		return
+	}
 
 	return resourceHpcLiteNewRead(ctx, rd, meta)
 }
@@ -249,45 +250,56 @@ func resourceHpcLiteNewRead(ctx context.Context, rd *schema.ResourceData, meta i
 
 	inst := meta.(*client.Instance)
 
-	serverNameToServerDetail := make(map[string]interface{})
-	for _, server := range rd.Get("server_details").([]interface{}) {
-		serverDetail := server.(map[string]interface{})
-		serverNameToServerDetail[serverDetail["server_name"].(string)] = serverDetail
-	}
-
-	var serverDetails []interface{}
-	serverIds := getServerIds(rd)
-	for _, serverId := range serverIds {
-		res, _, err := inst.Client.HpcLiteNew.GetHpcLiteNewDetail(ctx, serverId)
+	nameToServerDetail := make(map[string]interface{})
+	var serverNames []string
+	var res hpclitenew2.HpcLitePlusOpenApiDetailResponseDto
+	for _, serverId := range getServerIds(rd) {
+		detail, _, err := inst.Client.HpcLiteNew.GetHpcLiteNewDetail(ctx, serverId)
 		if err != nil {
-			rd.SetId("")
+			if common.IsDeleted(err) {
+				continue
+			}
 			return diag.FromErr(err)
 		}
-		// not import
-		if _, hasKey := serverNameToServerDetail[res.ServerName]; hasKey {
-			serverDetail := serverNameToServerDetail[res.ServerName].(map[string]interface{})
-			serverDetail["id"] = serverId
-			if serverDetail["ip_address"] == nil || serverDetail["ip_address"] == "" {
-				serverDetail["ip_address"] = res.IpAddress
-			}
-		} else {
-			serverDetail := make(map[string]interface{})
-			serverDetail["id"] = serverId
-			serverDetail["server_name"] = res.ServerName
-			serverDetail["ip_address"] = res.IpAddress
+		if len(serverNames) == 0 {
+			res = detail
+		}
+		serverNames = append(serverNames, detail.ServerName)
+		nameToServerDetail[detail.ServerName] = map[string]interface{}{
+			"id":          serverId,
+			"server_name": detail.ServerName,
+			"ip_address":  detail.IpAddress,
+			"state":       strings.ToUpper(detail.ServerState),
+		}
+	}
+
+	if len(serverNames) == 0 {
+		rd.SetId("")
+		return nil
+	}
+
+	// 설정된 순서를 유지하고, 설정에 없는 서버(import 등)는 뒤에 추가
+	var serverDetails []interface{}
+	for _, server := range rd.Get("server_details").([]interface{}) {
+		serverName := server.(map[string]interface{})["server_name"].(string)
+		if serverDetail, hasKey := nameToServerDetail[serverName]; hasKey {
 			serverDetails = append(serverDetails, serverDetail)
+			delete(nameToServerDetail, serverName)
 		}
 	}
-	if len(serverDetails) > 0 {
-		rd.Set("server_details", serverDetails)
+	for _, serverName := range serverNames {
+		if serverDetail, hasKey := nameToServerDetail[serverName]; hasKey {
+			serverDetails = append(serverDetails, serverDetail)
+		}
 	}
-	res, _, err := inst.Client.HpcLiteNew.GetHpcLiteNewDetail(ctx, serverIds[0])
+	rd.Set("server_details", serverDetails)
+
 	if _, exists := rd.GetOk("co_service_zone_id"); !exists {
 		rd.Set("co_service_zone_id", res.CoServiceZone)
 	}
-	if _, exists := rd.GetOk("contract"); !exists {
-		rd.Set("contract", res.Contract)
-	}
+	rd.Set("contract", res.Contract)
+	rd.Set("next_contract", res.NextContract)
+	rd.Set("contract_end_date", res.ContractEndDate)
 	if _, exists := rd.GetOk("hyper_threading_enabled"); !exists {
 		rd.Set("hyper_threading_enabled", res.HyperThreading)
 	}
@@ -311,7 +323,7 @@ func resourceHpcLiteNewRead(ctx context.Context, rd *schema.ResourceData, meta i
 	}
 	//rd.Set("vlan_pool_cidr", res.)
 
-	tfTags.SetTags(ctx, rd, meta, rd.Id())
+	tfTags.SetTags(ctx, rd, meta, getServerIds(rd)[0])
 	return nil
 }
 
@@ -323,75 +335,81 @@ func resourceHpcLiteNewUpdate(ctx context.Context, rd *schema.ResourceData, meta
 			diagnostics = diag.FromErr(err)
 		}
 	}()
+	oldServerIds := getServerIds(rd)
 	if rd.HasChanges("server_details") {
-		ov, nv := rd.GetChange("server_details")
-		oldServerDetails := ov.([]interface{})
-		newServerDetails := nv.([]interface{})
-
-		oldDetails, _ := mapServerNameToDetail(oldServerDetails)
+		newServerDetails := rd.Get("server_details").([]interface{})
 		newDetails, hasDuplicatedName := mapServerNameToDetail(newServerDetails)
 		if hasDuplicatedName {
-			errMsg := "Server Name is duplicated."
-			err := rd.Set("server_details", ov)
+			return diag.Errorf("Server Name is duplicated.")
+		}
+
+		nameToServerId, err := getServerNameToServerId(ctx, inst.Client, getServerIds(rd))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// scale in : 설정에서 제거된 서버만 삭제
+		var deleteServerNames []string
+		var deleteServerIds []string
+		for serverName, serverId := range nameToServerId {
+			if _, hasKey := newDetails[serverName]; !hasKey {
+				deleteServerNames = append(deleteServerNames, serverName)
+				deleteServerIds = append(deleteServerIds, serverId)
+			}
+		}
+		if len(deleteServerIds) > 0 {
+			err = deleteHpcLiteNewServers(ctx, rd, deleteServerIds, inst)
 			if err != nil {
-				errMsg += err.Error()
+				return diag.FromErr(err)
 			}
-			return diag.Errorf(errMsg)
-		}
-
-		if len(oldDetails) > len(newDetails) {
-			var deleteServerIds []string
-			var notDeleteServerIds []string
-			for serverName := range oldDetails {
-				serverId := oldDetails[serverName].(map[string]interface{})["id"].(string)
-				if _, hasKey := newDetails[serverName]; hasKey {
-					notDeleteServerIds = append(notDeleteServerIds, serverId)
-				} else {
-					deleteServerIds = append(deleteServerIds, serverId)
-				}
+			for _, serverName := range deleteServerNames {
+				delete(nameToServerId, serverName)
 			}
+			setResourceIdByServerDetails(rd, newServerDetails, nameToServerId)
+		}
 
-			deleteHpcLiteNewServers(ctx, rd, deleteServerIds, inst)
-			setResourceId(rd, notDeleteServerIds)
-		} else if len(oldDetails) < len(newDetails) {
-			var serverDetailsRequestList []hpclitenew.ServerDetailRequest
-			for serverName := range newDetails {
-				if _, hasKey := oldDetails[serverName]; !hasKey {
-					serverDetailsRequestList = append(serverDetailsRequestList, hpclitenew.ServerDetailRequest{
-						ServerName: serverName,
-						IpAddress:  newDetails[serverName].(map[string]interface{})["ip_address"].(string),
-					})
-				}
-			}
-			request := hpclitenew.HpcLiteNewCreateRequest{
-				CoServiceZoneId:       rd.Get("co_service_zone_id").(string),
-				Contract:              rd.Get("contract").(string),
-				HyperThreadingEnabled: rd.Get("hyper_threading_enabled").(string),
-				ImageId:               rd.Get("image_id").(string),
-				InitScript:            rd.Get("init_script").(string),
-				OsUserId:              rd.Get("os_user_id").(string),
-				OsUserPassword:        rd.Get("os_user_password").(string),
-				ProductGroupId:        rd.Get("product_group_id").(string),
-				ResourcePoolId:        rd.Get("resource_pool_id").(string),
-				ServerDetails:         serverDetailsRequestList,
-				ServerType:            rd.Get("server_type").(string),
-				ServiceZoneId:         rd.Get("service_zone_id").(string),
-				Tags:                  rd.Get("tags").(map[string]interface{}),
-				VlanPoolCidr:          rd.Get("vlan_pool_cidr").(string),
+		// scale out : 설정에 추가된 서버만 생성
+		var serverDetailsRequestList []hpclitenew.ServerDetailRequest
+		for _, server := range newServerDetails {
+			serverDetail := server.(map[string]interface{})
+			if _, hasKey := nameToServerId[serverDetail["server_name"].(string)]; !hasKey {
+				serverDetailsRequestList = append(serverDetailsRequestList, hpclitenew.ServerDetailRequest{
+					ServerName: serverDetail["server_name"].(string),
+					IpAddress:  serverDetail["ip_address"].(string),
+				})
 			}
-			response, _, err := inst.Client.HpcLiteNew.CreateHpcLiteNew(ctx, request)
+		}
+		if len(serverDetailsRequestList) > 0 {
+			createdServerIds, err := createHpcLiteNewServers(ctx, rd, serverDetailsRequestList, inst)
 			if err != nil {
-				diag.FromErr(err)
+				return diag.FromErr(err)
+			}
+			for serverName, serverId := range createdServerIds {
+				nameToServerId[serverName] = serverId
 			}
-			currentServerIds := getServerIds(rd)
-			for _, serverId := range response.ResourceIdList {
-				err = waitForAllHpcLiteNewStatus(ctx, inst.Client, serverId, []string{common.CreatingState}, []string{common.RunningState}, true)
-				if err != nil {
-					diag.FromErr(err)
+			setResourceIdByServerDetails(rd, newServerDetails, nameToServerId)
+		}
+
+		err = updateHpcLiteNewServerStates(ctx, rd, newServerDetails, nameToServerId, inst)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if rd.HasChanges("contract", "next_contract") {
+		// 새로 생성된 서버는 변경된 약정으로 생성되므로 기존 서버만 변경
+		var serverIds []string
+		for _, serverId := range getServerIds(rd) {
+			for _, oldServerId := range oldServerIds {
+				if serverId == oldServerId {
+					serverIds = append(serverIds, serverId)
 				}
-				currentServerIds = append(currentServerIds, serverId)
 			}
-			setResourceId(rd, currentServerIds)
+		}
+		if len(serverIds) > 0 {
+			err = updateHpcLiteNewContract(ctx, rd, serverIds, inst)
+			if err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 	if rd.HasChanges("tags") {
@@ -403,6 +421,124 @@ func resourceHpcLiteNewUpdate(ctx context.Context, rd *schema.ResourceData, meta
 	return resourceHpcLiteNewRead(ctx, rd, meta)
 }
 
+// 공통 설정으로 주어진 서버들을 생성하고 서버 이름별 id 를 반환
+func createHpcLiteNewServers(ctx context.Context, rd *schema.ResourceData, serverDetailsRequestList []hpclitenew.ServerDetailRequest, inst *client.Instance) (map[string]string, error) {
+	request := hpclitenew.HpcLiteNewCreateRequest{
+		CoServiceZoneId:       rd.Get("co_service_zone_id").(string),
+		Contract:              rd.Get("contract").(string),
+		HyperThreadingEnabled: rd.Get("hyper_threading_enabled").(string),
+		ImageId:               rd.Get("image_id").(string),
+		InitScript:            rd.Get("init_script").(string),
+		OsUserId:              rd.Get("os_user_id").(string),
+		OsUserPassword:        rd.Get("os_user_password").(string),
+		ProductGroupId:        rd.Get("product_group_id").(string),
+		ResourcePoolId:        rd.Get("resource_pool_id").(string),
+		ServerDetails:         serverDetailsRequestList,
+		ServerType:            rd.Get("server_type").(string),
+		ServiceZoneId:         rd.Get("service_zone_id").(string),
+		Tags:                  rd.Get("tags").(map[string]interface{}),
+		VlanPoolCidr:          rd.Get("vlan_pool_cidr").(string),
+	}
+
+	response, _, err := inst.Client.HpcLiteNew.CreateHpcLiteNew(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, serverId := range response.ResourceIdList {
+		err = waitForAllHpcLiteNewStatus(ctx, inst.Client, serverId, []string{common.CreatingState}, []string{common.RunningState}, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return getServerNameToServerId(ctx, inst.Client, response.ResourceIdList)
+}
+
+// 설정된 state 와 다른 서버들을 시작/중지
+func updateHpcLiteNewServerStates(ctx context.Context, rd *schema.ResourceData, serverDetails []interface{}, nameToServerId map[string]string, inst *client.Instance) error {
+	var startServerIds []string
+	var stopServerIds []string
+	for _, server := range serverDetails {
+		serverDetail := server.(map[string]interface{})
+		targetState, _ := serverDetail["state"].(string)
+		serverId, hasKey := nameToServerId[serverDetail["server_name"].(string)]
+		if len(targetState) == 0 || !hasKey {
+			continue
+		}
+
+		res, _, err := inst.Client.HpcLiteNew.GetHpcLiteNewDetail(ctx, serverId)
+		if err != nil {
+			return err
+		}
+		if strings.ToUpper(res.ServerState) == targetState {
+			continue
+		}
+
+		if targetState == common.StoppedState {
+			stopServerIds = append(stopServerIds, serverId)
+		} else {
+			startServerIds = append(startServerIds, serverId)
+		}
+	}
+
+	serviceZoneId := rd.Get("service_zone_id").(string)
+
+	// 실행(RUNNING) -> 중지(STOPPED)
+	if len(stopServerIds) > 0 {
+		_, _, err := inst.Client.HpcLiteNew.StopHpcLiteNew(ctx, hpclitenew.HpcLiteNewStartStopRequest{
+			ServerIds:     stopServerIds,
+			ServiceZoneId: serviceZoneId,
+		})
+		if err != nil {
+			return err
+		}
+		for _, serverId := range stopServerIds {
+			err = waitForAllHpcLiteNewStatus(ctx, inst.Client, serverId, common.VirtualServerProcessingStates(), []string{common.StoppedState}, true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// 중지(STOPPED) -> 시작(RUNNING)
+	if len(startServerIds) > 0 {
+		_, _, err := inst.Client.HpcLiteNew.StartHpcLiteNew(ctx, hpclitenew.HpcLiteNewStartStopRequest{
+			ServerIds:     startServerIds,
+			ServiceZoneId: serviceZoneId,
+		})
+		if err != nil {
+			return err
+		}
+		for _, serverId := range startServerIds {
+			err = waitForAllHpcLiteNewStatus(ctx, inst.Client, serverId, common.VirtualServerProcessingStates(), []string{common.RunningState}, true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func getServerNameToServerId(ctx context.Context, scpClient *client.SCPClient, serverIds []string) (map[string]string, error) {
+	nameToServerId := make(map[string]string)
+	for _, serverId := range serverIds {
+		if len(serverId) == 0 {
+			continue
+		}
+		res, _, err := scpClient.HpcLiteNew.GetHpcLiteNewDetail(ctx, serverId)
+		if err != nil {
+			if common.IsDeleted(err) {
+				continue
+			}
+			return nil, err
+		}
+		nameToServerId[res.ServerName] = serverId
+	}
+	return nameToServerId, nil
+}
+
 func mapServerNameToDetail(serverDetails []interface{}) (map[string]interface{}, bool) {
 	nameToDetails := make(map[string]interface{})
 	for _, v := range serverDetails {
@@ -417,35 +553,73 @@ func resourceHpcLiteNewDelete(ctx context.Context, rd *schema.ResourceData, meta
 	inst := meta.(*client.Instance)
 
 	deleteServerIds := strings.Split(rd.Id(), ",")
-	deleteHpcLiteNewServers(ctx, rd, deleteServerIds, inst)
+	err := deleteHpcLiteNewServers(ctx, rd, deleteServerIds, inst)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
 
-func deleteHpcLiteNewServers(ctx context.Context, rd *schema.ResourceData, deleteServerIds []string, inst *client.Instance) {
+func updateHpcLiteNewContract(ctx context.Context, rd *schema.ResourceData, serverIds []string, inst *client.Instance) error {
+	// 생성 시에는 contract 가 생성 요청에 포함되므로 next_contract 만 변경
+	if !rd.IsNewResource() && rd.HasChange("contract") {
+		_, _, err := inst.Client.HpcLiteNew.UpdateHpcLiteNewContract(ctx, hpclitenew.HpcLiteNewContractUpdateRequest{
+			Contract:      rd.Get("contract").(string),
+			ServerIds:     serverIds,
+			ServiceZoneId: rd.Get("service_zone_id").(string),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if common.HasContractChange(rd, "next_contract") {
+		_, _, err := inst.Client.HpcLiteNew.UpdateHpcLiteNewNextContract(ctx, hpclitenew.HpcLiteNewContractUpdateRequest{
+			Contract:      rd.Get("next_contract").(string),
+			ServerIds:     serverIds,
+			ServiceZoneId: rd.Get("service_zone_id").(string),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, serverId := range serverIds {
+		err := waitForAllHpcLiteNewStatus(ctx, inst.Client, serverId, common.VirtualServerProcessingStates(), []string{common.RunningState, common.StoppedState}, true)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteHpcLiteNewServers(ctx context.Context, rd *schema.ResourceData, deleteServerIds []string, inst *client.Instance) error {
 	request := hpclitenew.HpcLiteNewDeleteRequest{
 		ServerIds:     deleteServerIds,
 		ServiceZoneId: rd.Get("service_zone_id").(string),
 	}
 
 	for _, serverId := range deleteServerIds {
-		err := waitForAllHpcLiteNewStatus(ctx, inst.Client, serverId, common.VirtualServerProcessingStates(), []string{common.RunningState}, true)
+		err := waitForAllHpcLiteNewStatus(ctx, inst.Client, serverId, common.VirtualServerProcessingStates(), []string{common.RunningState, common.StoppedState}, true)
 		if err != nil {
-			diag.FromErr(err)
+			return err
 		}
 	}
 
 	_, _, err := inst.Client.HpcLiteNew.DeleteHpcLiteNew(ctx, request)
-	if err != nil {
-		diag.FromErr(err)
+	if err != nil && !common.IsDeleted(err) {
+		return err
 	}
 
 	for _, serverId := range deleteServerIds {
 		err = waitForAllHpcLiteNewStatus(ctx, inst.Client, serverId, common.VirtualServerProcessingStates(), []string{common.DeletedState}, false)
 		if err != nil {
-			diag.FromErr(err)
+			return err
 		}
 	}
+
+	return nil
 }
 
 func waitForAllHpcLiteNewStatus(ctx context.Context, scpClient *client.SCPClient, serverId string, pendingStates []string, targetStates []string, checkNotFound bool) error {
@@ -465,7 +639,14 @@ func waitForAllHpcLiteNewStatus(ctx context.Context, scpClient *client.SCPClient
 	})
 }
 
-func setResourceId(rd *schema.ResourceData, resourceIdList []string) {
+// server_details 순서대로 서버 id 를 resource id 에 저장
+func setResourceIdByServerDetails(rd *schema.ResourceData, serverDetails []interface{}, nameToServerId map[string]string) {
+	var resourceIdList []string
+	for _, server := range serverDetails {
+		if serverId, hasKey := nameToServerId[server.(map[string]interface{})["server_name"].(string)]; hasKey {
+			resourceIdList = append(resourceIdList, serverId)
+		}
+	}
 	rd.SetId(strings.Join(resourceIdList, ","))
 }
 
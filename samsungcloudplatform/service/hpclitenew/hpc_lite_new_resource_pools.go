@@ -0,0 +1,81 @@
+package hpclitenew
+
+import (
+	"context"
+
+	scp "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	uuid "github.com/satori/go.uuid"
+)
+
+func init() {
+	scp.RegisterDataSource("HPC Lite(NEW)", "samsungcloudplatform_hpc_lite_new_resource_pools", DatasourceHpcLiteNewResourcePools())
+}
+
+func DatasourceHpcLiteNewResourcePools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceHpcLiteNewResourcePoolsRead,
+		Schema: map[string]*schema.Schema{
+			"service_zone_id":  {Type: schema.TypeString, Required: true, Description: "HPC Lite(New) Service Zone ID"},
+			"server_type":      {Type: schema.TypeString, Optional: true, Description: "Server type to filter resource pools"},
+			"min_free_servers": {Type: schema.TypeInt, Optional: true, Default: 0, Description: "Only return resource pools with at least this many free servers"},
+			"contents":         {Type: schema.TypeList, Computed: true, Description: "Resource pool list", Elem: datasourceResourcePoolElem()},
+			"total_count":      {Type: schema.TypeInt, Computed: true, Description: "Total list size"},
+		},
+		Description: "Provides list of HPC Lite(New) resource pools with their free capacity",
+	}
+}
+
+func datasourceResourcePoolElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"resource_pool_id":   {Type: schema.TypeString, Computed: true, Description: "Resource pool id"},
+			"resource_pool_name": {Type: schema.TypeString, Computed: true, Description: "Resource pool name"},
+			"server_type":        {Type: schema.TypeString, Computed: true, Description: "Server type of the resource pool"},
+			"total_servers":      {Type: schema.TypeInt, Computed: true, Description: "Total number of servers in the resource pool"},
+			"used_servers":       {Type: schema.TypeInt, Computed: true, Description: "Number of servers in use"},
+			"free_servers":       {Type: schema.TypeInt, Computed: true, Description: "Number of servers available for new nodes"},
+		},
+	}
+}
+
+func dataSourceHpcLiteNewResourcePoolsRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	responses, _, err := inst.Client.HpcLiteNew.GetHpcLiteNewResourcePoolList(ctx, rd.Get("service_zone_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverType := rd.Get("server_type").(string)
+	minFreeServers := rd.Get("min_free_servers").(int)
+
+	contents := make([]map[string]interface{}, 0)
+	for _, pool := range responses.Contents {
+		if len(serverType) > 0 && pool.ServerType != serverType {
+			continue
+		}
+
+		freeServers := int(pool.TotalServerCount - pool.UsedServerCount)
+		if freeServers < minFreeServers {
+			continue
+		}
+
+		contents = append(contents, map[string]interface{}{
+			"resource_pool_id":   pool.ResourcePoolId,
+			"resource_pool_name": pool.ResourcePoolName,
+			"server_type":        pool.ServerType,
+			"total_servers":      pool.TotalServerCount,
+			"used_servers":       pool.UsedServerCount,
+			"free_servers":       freeServers,
+		})
+	}
+
+	rd.SetId(uuid.NewV4().String())
+	rd.Set("contents", contents)
+	rd.Set("total_count", len(contents))
+
+	return nil
+}
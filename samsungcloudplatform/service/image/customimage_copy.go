@@ -0,0 +1,162 @@
+package image
+
+import (
+	"context"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	tfTags "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/tag"
+	image "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/image2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func init() {
+	samsungcloudplatform.RegisterResource("Image", "samsungcloudplatform_custom_image_copy", ResourceCustomImageCopy())
+}
+
+func ResourceCustomImageCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomImageCopyCreate,
+		ReadContext:   resourceCustomImageCopyRead,
+		UpdateContext: resourceCustomImageCopyUpdate,
+		DeleteContext: resourceCustomImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"source_image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Custom image id to copy.",
+			},
+			"image_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Name of the copied custom image.",
+				ValidateDiagFunc: common.ValidateName3to60AlphaNumericWithSpaceDashUnderscoreStartsWithLowerAlpha,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Region name to copy the custom image to. Uses the default region of the project when not set.",
+			},
+			"availability_zone_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Availability zone name to copy the custom image to.",
+			},
+			"image_description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Custom image description.",
+				ValidateDiagFunc: common.ValidateDescriptionMaxlength50,
+			},
+			"tags":            tfTags.TagsSchema(),
+			"image_id":        {Type: schema.TypeString, Computed: true},
+			"image_state":     {Type: schema.TypeString, Computed: true, Description: "Image state (ACTIVE)"},
+			"os_type":         {Type: schema.TypeString, Computed: true, Description: "OS type (Windows, Ubuntu, ..)"},
+			"service_zone_id": {Type: schema.TypeString, Computed: true},
+			"created_by":      {Type: schema.TypeString, Computed: true},
+			"created_dt":      {Type: schema.TypeString, Computed: true},
+		},
+		Description: "Provides a copy of a Custom Image in another region or availability zone.",
+	}
+}
+
+func resourceCustomImageCopyCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	sourceImageId := rd.Get("source_image_id").(string)
+
+	var serviceZoneId string
+	region := rd.Get("region").(string)
+	if len(region) == 0 {
+		projectInfo, err := inst.Client.Project.GetProjectInfo(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		serviceZoneId = projectInfo.DefaultZoneId
+	} else {
+		var err error
+		serviceZoneId, _, err = client.FindServiceZoneIdAndProductGroupId(ctx, inst.Client, region, common.NetworkProductGroup, common.VpcProductName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := waitForCustomImageStatus(ctx, inst.Client, sourceImageId, []string{}, []string{"ACTIVE"}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, _, err := inst.Client.CustomImage.CopyCustomImage(ctx, sourceImageId, image.CustomImageCopyRequest{
+		ImageName:            rd.Get("image_name").(string),
+		ImageDescription:     rd.Get("image_description").(string),
+		ServiceZoneId:        serviceZoneId,
+		AvailabilityZoneName: rd.Get("availability_zone_name").(string),
+	}, rd.Get("tags").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = waitForCustomImageStatus(ctx, inst.Client, response.ResourceId, []string{}, []string{"ACTIVE"}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(response.ResourceId)
+
+	return resourceCustomImageCopyRead(ctx, rd, meta)
+}
+
+func resourceCustomImageCopyRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	responseCustomImage, _, err := inst.Client.CustomImage.GetCustomImage(ctx, rd.Id())
+	if err != nil {
+		rd.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	rd.Set("image_id", responseCustomImage.ImageId)
+	rd.Set("image_name", responseCustomImage.ImageName)
+	rd.Set("image_description", responseCustomImage.ImageDescription)
+	rd.Set("image_state", responseCustomImage.ImageState)
+	rd.Set("os_type", responseCustomImage.OsType)
+	rd.Set("service_zone_id", responseCustomImage.ServiceZoneId)
+	rd.Set("availability_zone_name", responseCustomImage.AvailabilityZoneName)
+	rd.Set("created_by", responseCustomImage.CreatedBy)
+	rd.Set("created_dt", responseCustomImage.CreatedDt.String())
+
+	tfTags.SetTags(ctx, rd, meta, rd.Id())
+
+	return nil
+}
+
+func resourceCustomImageCopyUpdate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	if rd.HasChanges("image_description") {
+		_, err := inst.Client.CustomImage.UpdateCustomImageDescription(ctx, rd.Id(), rd.Get("image_description").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := tfTags.UpdateTags(ctx, rd, meta, rd.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCustomImageCopyRead(ctx, rd, meta)
+}
@@ -0,0 +1,116 @@
+package image
+
+import (
+	"context"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	image "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/image2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func init() {
+	samsungcloudplatform.RegisterResource("Image", "samsungcloudplatform_custom_image_share", ResourceCustomImageShare())
+}
+
+func ResourceCustomImageShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomImageShareCreate,
+		ReadContext:   resourceCustomImageShareRead,
+		DeleteContext: resourceCustomImageShareDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Custom image id to share.",
+			},
+			"target_project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project id that is granted access to the custom image.",
+			},
+			"share_state": {Type: schema.TypeString, Computed: true, Description: "Share state"},
+			"created_by":  {Type: schema.TypeString, Computed: true},
+			"created_dt":  {Type: schema.TypeString, Computed: true},
+		},
+		Description: "Provides a Custom Image share resource that grants another project access to a custom image.",
+	}
+}
+
+func resourceCustomImageShareCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	imageId := rd.Get("image_id").(string)
+
+	err := waitForCustomImageStatus(ctx, inst.Client, imageId, []string{}, []string{"ACTIVE"}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, _, err := inst.Client.CustomImage.CreateCustomImageShare(ctx, imageId, rd.Get("target_project_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(response.ShareId)
+
+	return resourceCustomImageShareRead(ctx, rd, meta)
+}
+
+func resourceCustomImageShareRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	share, found, err := findCustomImageShare(ctx, inst.Client, rd.Get("image_id").(string), rd.Id())
+	if err != nil {
+		rd.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if !found {
+		rd.SetId("")
+		return nil
+	}
+
+	rd.Set("target_project_id", share.TargetProjectId)
+	rd.Set("share_state", share.ShareState)
+	rd.Set("created_by", share.CreatedBy)
+	rd.Set("created_dt", share.CreatedDt.String())
+
+	return nil
+}
+
+func resourceCustomImageShareDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	_, err := inst.Client.CustomImage.DeleteCustomImageShare(ctx, rd.Get("image_id").(string), rd.Id())
+	if err != nil && !common.IsDeleted(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func findCustomImageShare(ctx context.Context, scpClient *client.SCPClient, imageId string, shareId string) (image.CustomImageShareResponse, bool, error) {
+	shares, _, err := scpClient.CustomImage.GetCustomImageShareList(ctx, imageId)
+	if err != nil {
+		return image.CustomImageShareResponse{}, false, err
+	}
+
+	for _, share := range shares.Contents {
+		if share.ShareId == shareId {
+			return share, true, nil
+		}
+	}
+
+	return image.CustomImageShareResponse{}, false, nil
+}
@@ -2,6 +2,9 @@ package image
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
@@ -29,14 +32,43 @@ func DatasourceStandardImage() *schema.Resource {
 			"filter": common.DatasourceFilter(),
 			"service_group": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Default:     "COMPUTE",
 				Description: "Service group (COMPUTE, CONTAINER, ...)",
 			},
 			"service": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Default:     "Virtual Server",
 				Description: "Service (Virtual Server, Kubernetes Engine VM, ...)",
 			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Select the most recently created image when several images match. Otherwise several matching images are an error.",
+			},
+			"version_constraint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Constraint on the OS version of the image (e.g. \">= 22.04\", \"~> 8.6\", \">= 22.04, < 24\")",
+			},
+			"os_distro": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "OS distribution taken from the image name (Ubuntu, Rocky Linux, ..)",
+			},
+			"os_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "OS version taken from the image name",
+			},
+			"created_dt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date",
+			},
 			"base_image": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -59,6 +91,7 @@ func DatasourceStandardImage() *schema.Resource {
 			},
 			"os_type": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "OS type (Windows, Ubuntu, ..)",
 			},
@@ -94,6 +127,9 @@ func convertStandImageListToHclSet(standardImages []image2.StandardImageResponse
 			"image_name":    si.ImageName,
 			"image_type":    si.ImageType,
 			"os_type":       si.OsType,
+			"os_distro":     common.ExtractVersionPrefix(si.ImageName),
+			"os_version":    common.ExtractVersion(si.ImageName),
+			"created_dt":    si.CreatedDt.Format(time.RFC3339),
 			"description":   si.ImageDescription,
 			"service":       service,
 			"service_group": serviceGroup,
@@ -104,6 +140,44 @@ func convertStandImageListToHclSet(standardImages []image2.StandardImageResponse
 	return setStandardImages, ids
 }
 
+// sortStandardImagesByCreatedDt sorts the images by creation date (newest first) so that the result does not depend on the API order
+func sortStandardImagesByCreatedDt(standardImages []image2.StandardImageResponse) {
+	sort.SliceStable(standardImages, func(i, j int) bool {
+		if !standardImages[i].CreatedDt.Equal(standardImages[j].CreatedDt) {
+			return standardImages[i].CreatedDt.After(standardImages[j].CreatedDt)
+		}
+		return standardImages[i].ImageId < standardImages[j].ImageId
+	})
+}
+
+// filterStandardImages keeps the images matching the os_type, os_distro and version_constraint arguments
+func filterStandardImages(rd *schema.ResourceData, standardImages common.HclSetObject) (common.HclSetObject, error) {
+	osType := rd.Get("os_type").(string)
+	osDistro := rd.Get("os_distro").(string)
+	versionConstraint := rd.Get("version_constraint").(string)
+
+	var result common.HclSetObject
+	for _, si := range standardImages {
+		if len(osType) != 0 && !strings.EqualFold(si["os_type"].(string), osType) {
+			continue
+		}
+		if len(osDistro) != 0 && !strings.EqualFold(si["os_distro"].(string), osDistro) {
+			continue
+		}
+		if len(versionConstraint) != 0 {
+			matched, err := common.MatchVersionConstraint(si["os_version"].(string), versionConstraint)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+		}
+		result = append(result, si)
+	}
+	return result, nil
+}
+
 func datasourceStandardImageRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	inst := meta.(*client.Instance)
 
@@ -132,16 +206,27 @@ func datasourceStandardImageRead(ctx context.Context, rd *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	sortStandardImagesByCreatedDt(responseStandardImages.Contents)
+
 	setStandardImages, _ := convertStandImageListToHclSet(responseStandardImages.Contents, serviceGroup, service)
 
 	if f, ok := rd.GetOk("filter"); ok {
 		setStandardImages = common.ApplyFilter(DatasourceStandardImage().Schema, f.(*schema.Set), setStandardImages)
 	}
 
+	setStandardImages, err = filterStandardImages(rd, setStandardImages)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(setStandardImages) == 0 {
 		return diag.Errorf("no matching standard image found")
 	}
 
+	if len(setStandardImages) > 1 && !rd.Get("most_recent").(bool) {
+		return diag.Errorf("%d standard images match. Narrow the filters or set most_recent to select the most recently created image", len(setStandardImages))
+	}
+
 	for k, v := range setStandardImages[0] {
 		if k == "id" {
 			rd.SetId(v.(string))
@@ -32,10 +32,25 @@ func DatasourceStandardImages() *schema.Resource {
 				Required:    true,
 				Description: "Service (Baremetal Server, EPAS, Elasticsearch, GPU Server, Kubeflow, Kubernetes Apps, Kubernetes Engine, Kubernetes Engine GPU VM, Kubernetes Engine VM, MariaDB, Microsoft SQL Server, MySQL, PostgreSQL, Redis, Tibero, Vertica, Virtual Server)",
 			},
+			"os_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "OS type (Windows, Ubuntu, ..)",
+			},
+			"os_distro": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "OS distribution taken from the image name (Ubuntu, Rocky Linux, ..)",
+			},
+			"version_constraint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Constraint on the OS version of the images (e.g. \">= 22.04\")",
+			},
 			"standard_images": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Standard image list",
+				Description: "Standard image list, sorted by creation date (newest first)",
 				Elem:        common.GetDatasourceItemsSchema(DatasourceStandardImage()),
 			},
 			"region": {
@@ -78,12 +93,24 @@ func datasourceStandardImagesRead(ctx context.Context, rd *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	setStandardImages, ids := convertStandImageListToHclSet(responseStandardImages.Contents, serviceGroup, service)
+	sortStandardImagesByCreatedDt(responseStandardImages.Contents)
+
+	setStandardImages, _ := convertStandImageListToHclSet(responseStandardImages.Contents, serviceGroup, service)
 
 	if f, ok := rd.GetOk("filter"); ok {
 		setStandardImages = common.ApplyFilter(DatasourceStandardImages().Schema, f.(*schema.Set), setStandardImages)
 	}
 
+	setStandardImages, err = filterStandardImages(rd, setStandardImages)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(setStandardImages))
+	for _, si := range setStandardImages {
+		ids = append(ids, si["id"].(string))
+	}
+
 	rd.SetId(common.GenerateHash(ids))
 	rd.Set("ids", ids)
 	rd.Set("standard_images", setStandardImages)
@@ -2,6 +2,10 @@ package image
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
@@ -10,6 +14,7 @@ import (
 	"github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/image2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func init() {
@@ -27,16 +32,47 @@ func ResourceMigrationImage() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"access_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "access key for ova",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				Deprecated:    "Use obs_bucket_id and object_key instead. The provider credentials are used to access the object storage.",
+				ConflictsWith: []string{"obs_bucket_id"},
+				RequiredWith:  []string{"ova_url", "secret_key"},
+				Description:   "access key for ova",
 			},
 			"secret_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "secret key for ova",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				Deprecated:    "Use obs_bucket_id and object_key instead. The provider credentials are used to access the object storage.",
+				ConflictsWith: []string{"obs_bucket_id"},
+				RequiredWith:  []string{"ova_url", "access_key"},
+				Description:   "secret key for ova",
+			},
+			"obs_bucket_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"obs_bucket_id", "ova_url"},
+				RequiredWith: []string{"object_key"},
+				Description:  "Object storage bucket id where the source image file is stored",
+			},
+			"object_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"obs_bucket_id"},
+				Description:  "Object key of the source image file in the object storage bucket",
+			},
+			"image_format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "OVA",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"OVA", "QCOW2", "VMDK", "RAW"}, false)),
+				Description:      "Format of the source image file (OVA, QCOW2, VMDK, RAW)",
 			},
 			"az_name": {
 				Type:        schema.TypeString,
@@ -51,10 +87,12 @@ func ResourceMigrationImage() *schema.Resource {
 				ValidateDiagFunc: common.ValidateName3to60AlphaNumericWithSpaceDashUnderscoreStartsWithLowerAlpha,
 			},
 			"ova_url": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Ova url",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Deprecated:    "Use obs_bucket_id and object_key instead.",
+				ConflictsWith: []string{"obs_bucket_id"},
+				Description:   "Ova url",
 			},
 			"os_user_id": {
 				Type:        schema.TypeString,
@@ -161,11 +199,20 @@ func resourceMigrationImageCreate(ctx context.Context, rd *schema.ResourceData,
 	ServiceZoneId := rd.Get("service_zone_id").(string)
 	ImageDescription := rd.Get("image_description").(string)
 
+	if obsBucketId, ok := rd.GetOk("obs_bucket_id"); ok {
+		objectUrl, err := getMigrationImageObjectUrl(ctx, inst.Client, obsBucketId.(string), rd.Get("object_key").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		OvaUrl = objectUrl
+	}
+
 	createRequest := image2.MigrationImageCreateRequest{
 		AccessKey:            AccessKey,
 		SecretKey:            SecretKey,
 		AvailabilityZoneName: AvailabilityZoneName,
 		ImageName:            ImageName,
+		ImageFormat:          rd.Get("image_format").(string),
 		OriginalImageId:      OriginalImageId,
 		OsAdminCredential:    &OsAdminCredential,
 		OvaUrl:               OvaUrl,
@@ -176,11 +223,33 @@ func resourceMigrationImageCreate(ctx context.Context, rd *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = waitMigrationImageCreating(ctx, inst.Client, response.ResourceId)
 	rd.SetId(response.ResourceId)
+
+	err = waitMigrationImageCreating(ctx, inst.Client, response.ResourceId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	return resourceMigrationImageRead(ctx, rd, meta)
 }
 
+// getMigrationImageObjectUrl returns the object url of the source image file in the bucket
+func getMigrationImageObjectUrl(ctx context.Context, scpClient *client.SCPClient, obsBucketId string, objectKey string) (string, error) {
+	bucketInfo, _, err := scpClient.ObjectStorage.ReadBucket(ctx, obsBucketId)
+	if err != nil {
+		return "", err
+	}
+
+	endpointUrl := bucketInfo.ObjectStorageBucketPrivateEndpointUrl
+	if len(endpointUrl) == 0 {
+		endpointUrl = bucketInfo.ObjectStorageBucketPublicEndpointUrl
+	}
+	if len(endpointUrl) == 0 {
+		return "", fmt.Errorf("no endpoint url found for object storage bucket %s", obsBucketId)
+	}
+
+	return strings.TrimRight(endpointUrl, "/") + "/" + strings.TrimLeft(objectKey, "/"), nil
+}
+
 func resourceMigrationImageRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	inst := meta.(*client.Instance)
@@ -189,7 +258,9 @@ func resourceMigrationImageRead(ctx context.Context, rd *schema.ResourceData, me
 		rd.SetId("")
 		return diag.FromErr(err)
 	}
-	rd.Set("name", MigrationImageInfo.ImageName)
+	rd.Set("image_name", MigrationImageInfo.ImageName)
+	rd.Set("image_id", MigrationImageInfo.ImageId)
+	rd.Set("image_state", MigrationImageInfo.ImageState)
 	tfTags.SetTags(ctx, rd, meta, rd.Id())
 
 	return nil
@@ -232,12 +303,14 @@ func resourceMigrationImageDelete(ctx context.Context, rd *schema.ResourceData,
 	return nil
 }
 
-func waitMigrationImageCreating(ctx context.Context, scpClient *client.SCPClient, vpcId string) error {
+func waitMigrationImageCreating(ctx context.Context, scpClient *client.SCPClient, migrationImageId string) error {
+	startTime := time.Now()
 	return client.WaitForStatus(ctx, scpClient, []string{"CREATING"}, []string{"ACTIVE"}, func() (interface{}, string, error) {
-		info, _, err := scpClient.MigrationImage.GetMigrationImageInfo(ctx, vpcId)
+		info, _, err := scpClient.MigrationImage.GetMigrationImageInfo(ctx, migrationImageId)
 		if err != nil {
 			return nil, "", err
 		}
+		log.Printf("[INFO] Migration image %s import : %s (elapsed %s)", migrationImageId, info.ImageState, time.Since(startTime).Round(time.Second))
 		return info, info.ImageState, nil
 	})
 }
@@ -2,14 +2,18 @@ package keypair
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/keypair"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
 	tfTags "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/tag"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
 )
 
 func init() {
@@ -33,11 +37,26 @@ func ResourceKeyPair() *schema.Resource {
 				ValidateDiagFunc: common.ValidateName3to28Dash,
 				Description:      "Key Pair Name",
 			},
+			"public_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validatePublicKey,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+				Description: "Existing public key to import in OpenSSH authorized_keys format (RSA, ECDSA or Ed25519). When set, the private key is not generated nor stored in state.",
+			},
 			"private_key": {
 				Type:             schema.TypeString,
 				Computed:         true,
 				ValidateDiagFunc: nil,
-				Description:      "Private Key",
+				Description:      "Private Key. Empty when public_key is set.",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 fingerprint of the public key",
 			},
 			"tags": tfTags.TagsSchema(),
 		},
@@ -56,6 +75,35 @@ func resourceKeyPairCreate(ctx context.Context, rd *schema.ResourceData, meta in
 
 	keyPairName := rd.Get("key_pair_name").(string)
 
+	if publicKey, ok := rd.GetOk("public_key"); ok {
+		fingerprint, err := getPublicKeyFingerprint(publicKey.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		response, err := inst.Client.KeyPair.ImportKeyPair(ctx, keypair.CreateRequest{
+			KeyPairName: keyPairName,
+			PublicKey:   strings.TrimSpace(publicKey.(string)),
+			Tags:        rd.Get("tags").(map[string]interface{}),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = WaitForKeyPairStatus(ctx, inst.Client, response.KeyPairId, []string{}, []string{common.ActiveState}, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// 가져온 공개키에 대응하는 개인키는 state 에 저장하지 않음
+		rd.SetId(response.KeyPairId)
+		rd.Set("private_key", "")
+		rd.Set("fingerprint", fingerprint)
+		rd.Set("key_pair_name", response.KeyPairName)
+
+		return nil
+	}
+
 	response, err := inst.Client.KeyPair.CreateKeyPair(ctx, keypair.CreateRequest{
 		KeyPairName: keyPairName,
 		Tags:        rd.Get("tags").(map[string]interface{}),
@@ -73,9 +121,35 @@ func resourceKeyPairCreate(ctx context.Context, rd *schema.ResourceData, meta in
 	rd.Set("private_key", response.PrivateKey)
 	rd.Set("key_pair_name", response.KeyPairName)
 
+	if signer, err := ssh.ParsePrivateKey([]byte(response.PrivateKey)); err == nil {
+		rd.Set("fingerprint", ssh.FingerprintSHA256(signer.PublicKey()))
+	}
+
+	return nil
+}
+
+func validatePublicKey(v interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := getPublicKeyFingerprint(v.(string)); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
+func getPublicKeyFingerprint(publicKey string) (string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(publicKey)))
+	if err != nil {
+		return "", fmt.Errorf("public_key is not a valid OpenSSH public key : %s", err)
+	}
+
+	switch key.Type() {
+	case ssh.KeyAlgoRSA, ssh.KeyAlgoED25519, ssh.KeyAlgoECDSA256, ssh.KeyAlgoECDSA384, ssh.KeyAlgoECDSA521:
+	default:
+		return "", fmt.Errorf("public_key type %s is not supported. Use an RSA, ECDSA or Ed25519 key", key.Type())
+	}
+
+	return ssh.FingerprintSHA256(key), nil
+}
+
 func resourceKeyPairRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) (diagnostics diag.Diagnostics) {
 	var err error = nil
 	defer func() {
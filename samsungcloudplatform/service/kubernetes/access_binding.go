@@ -0,0 +1,268 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+const rbacApiGroup = "rbac.authorization.k8s.io"
+
+func init() {
+	samsungcloudplatform.RegisterResource("Kubernetes", "samsungcloudplatform_kubernetes_access_binding", ResourceKubernetesAccessBinding())
+}
+
+func ResourceKubernetesAccessBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createAccessBinding,
+		ReadContext:   readAccessBinding,
+		UpdateContext: updateAccessBinding,
+		DeleteContext: deleteAccessBinding,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importAccessBinding,
+		},
+
+		CustomizeDiff: validateAccessBinding,
+
+		Schema: map[string]*schema.Schema{
+			"engine_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of scp_kubernetes_engine resource",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 253),
+				Description:  "Binding name",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Namespace to bind the role in. If empty, the role is bound cluster wide",
+			},
+			"role_kind": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "ClusterRole",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ClusterRole", "Role"}, false)),
+				Description:      "Kind of the role (ClusterRole|Role). Role requires namespace",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Role name (e.g. cluster-admin, admin, edit, view)",
+			},
+			"iam_group_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"iam_group_ids", "iam_member_ids"},
+				Description:  "IAM group IDs to bind",
+			},
+			"iam_member_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"iam_group_ids", "iam_member_ids"},
+				Description:  "IAM member (user) IDs to bind",
+			},
+		},
+		Description: "Binds IAM groups and members to a Kubernetes cluster role or namespace role. IAM groups are bound as Group subjects and IAM members as User subjects.",
+	}
+}
+
+type roleBindingObject struct {
+	ApiVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name      string `yaml:"name"`
+		Namespace string `yaml:"namespace,omitempty"`
+	} `yaml:"metadata"`
+	RoleRef struct {
+		ApiGroup string `yaml:"apiGroup"`
+		Kind     string `yaml:"kind"`
+		Name     string `yaml:"name"`
+	} `yaml:"roleRef"`
+	Subjects []roleBindingSubject `yaml:"subjects"`
+}
+
+type roleBindingSubject struct {
+	ApiGroup string `yaml:"apiGroup"`
+	Kind     string `yaml:"kind"`
+	Name     string `yaml:"name"`
+}
+
+func validateAccessBinding(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("role_kind").(string) == "Role" && len(diff.Get("namespace").(string)) == 0 {
+		return fmt.Errorf("namespace is required when role_kind is Role")
+	}
+	return nil
+}
+
+func createAccessBinding(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+	engineId := data.Get("engine_id").(string)
+	namespace := data.Get("namespace").(string)
+	name := data.Get("name").(string)
+
+	bindingYaml, err := getRoleBindingYaml(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, _, err = inst.Client.Kubernetes.CreateObject(ctx, engineId, bindingYaml)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strings.Join([]string{engineId, namespace, name}, "/"))
+
+	return readAccessBinding(ctx, data, meta)
+}
+
+// 클러스터의 RoleBinding / ClusterRoleBinding 을 읽어 state 에 반영한다. (kubectl 등으로 변경된 subject 는 다음 apply 에서 되돌린다)
+func readAccessBinding(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+	engineId := data.Get("engine_id").(string)
+	namespace := data.Get("namespace").(string)
+
+	bindingYaml, _, err := inst.Client.Kubernetes.ReadObject(ctx, engineId, getRoleBindingKind(namespace), namespace, data.Get("name").(string))
+	if err != nil {
+		data.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var binding roleBindingObject
+	if err := yaml.Unmarshal([]byte(bindingYaml), &binding); err != nil {
+		return diag.Errorf("failed to parse role binding : %v", err)
+	}
+
+	groupIds := make([]string, 0)
+	memberIds := make([]string, 0)
+	for _, subject := range binding.Subjects {
+		switch subject.Kind {
+		case "Group":
+			groupIds = append(groupIds, subject.Name)
+		case "User":
+			memberIds = append(memberIds, subject.Name)
+		}
+	}
+
+	data.Set("role_kind", binding.RoleRef.Kind)
+	data.Set("role_name", binding.RoleRef.Name)
+	data.Set("iam_group_ids", groupIds)
+	data.Set("iam_member_ids", memberIds)
+
+	return nil
+}
+
+func updateAccessBinding(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	if data.HasChanges("iam_group_ids", "iam_member_ids") {
+		bindingYaml, err := getRoleBindingYaml(data)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, _, err = inst.Client.Kubernetes.UpdateObject(ctx, data.Get("engine_id").(string), bindingYaml)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readAccessBinding(ctx, data, meta)
+}
+
+func deleteAccessBinding(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	bindingYaml, err := getRoleBindingYaml(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = inst.Client.Kubernetes.DeleteObject(ctx, data.Get("engine_id").(string), bindingYaml)
+	if err != nil && !common.IsDeleted(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// import ID 형식 : {engine_id}/{namespace}/{name} (cluster 범위는 namespace 를 비워둔다. e.g. {engine_id}//{name})
+func importAccessBinding(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(data.Id(), "/")
+	if len(parts) != 3 || len(parts[0]) == 0 || len(parts[2]) == 0 {
+		return nil, fmt.Errorf("invalid import ID %q : expected {engine_id}/{namespace}/{name}", data.Id())
+	}
+
+	data.Set("engine_id", parts[0])
+	data.Set("namespace", parts[1])
+	data.Set("name", parts[2])
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func getRoleBindingKind(namespace string) string {
+	if len(namespace) == 0 {
+		return "ClusterRoleBinding"
+	}
+	return "RoleBinding"
+}
+
+func getRoleBindingYaml(data *schema.ResourceData) (string, error) {
+	namespace := data.Get("namespace").(string)
+
+	binding := roleBindingObject{
+		ApiVersion: rbacApiGroup + "/v1",
+		Kind:       getRoleBindingKind(namespace),
+	}
+	binding.Metadata.Name = data.Get("name").(string)
+	binding.Metadata.Namespace = namespace
+	binding.RoleRef.ApiGroup = rbacApiGroup
+	binding.RoleRef.Kind = data.Get("role_kind").(string)
+	binding.RoleRef.Name = data.Get("role_name").(string)
+
+	binding.Subjects = make([]roleBindingSubject, 0)
+	for _, groupId := range toSortedStrings(data.Get("iam_group_ids").(*schema.Set)) {
+		binding.Subjects = append(binding.Subjects, roleBindingSubject{ApiGroup: rbacApiGroup, Kind: "Group", Name: groupId})
+	}
+	for _, memberId := range toSortedStrings(data.Get("iam_member_ids").(*schema.Set)) {
+		binding.Subjects = append(binding.Subjects, roleBindingSubject{ApiGroup: rbacApiGroup, Kind: "User", Name: memberId})
+	}
+
+	out, err := yaml.Marshal(binding)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func toSortedStrings(set *schema.Set) []string {
+	result := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		result = append(result, v.(string))
+	}
+	sort.Strings(result)
+	return result
+}
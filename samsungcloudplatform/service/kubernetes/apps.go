@@ -3,11 +3,15 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
 	tfTags "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/tag"
+	kubernetesapps "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/kubernetes-apps"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -27,6 +31,8 @@ func ResourceKubernetesApps() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeAppsImageDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -49,15 +55,36 @@ func ResourceKubernetesApps() *schema.Resource {
 			"image_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "Image ID (use scp_standard_image data source)",
+				Description: "Image ID (use scp_standard_image data source). Moving to a newer version of the same app upgrades the release in place",
 			},
 			"additional_params": {
 				Type:        schema.TypeMap,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Additional Params",
 			},
+			"wait_for_ready": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait until the release is deployed after install or upgrade",
+			},
+			"release_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Release status",
+			},
+			"deployed_objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind":      {Type: schema.TypeString, Computed: true, Description: "Object kind"},
+						"name":      {Type: schema.TypeString, Computed: true, Description: "Object name"},
+						"namespace": {Type: schema.TypeString, Computed: true, Description: "Object namespace"},
+					},
+				},
+				Description: "Kubernetes objects deployed by the release",
+			},
 			"tags": tfTags.TagsSchema(),
 		},
 		Description: "Provides a K8s Apps resource.",
@@ -86,9 +113,11 @@ func createApps(ctx context.Context, data *schema.ResourceData, meta interface{}
 
 	data.SetId(apps.ReleaseId)
 
-	err = client.WaitForStatus(ctx, inst.Client, []string{}, []string{"deployed"}, refreshApps(ctx, meta, data.Id(), true))
-	if err != nil {
-		return diag.FromErr(err)
+	if data.Get("wait_for_ready").(bool) {
+		err = client.WaitForStatus(ctx, inst.Client, []string{}, []string{"deployed"}, refreshApps(ctx, meta, data.Id(), true))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return readApps(ctx, data, meta)
@@ -110,6 +139,23 @@ func readApps(ctx context.Context, data *schema.ResourceData, meta interface{})
 	data.Set("engine_id", apps.ClusterId)
 	data.Set("namespace", apps.NamespaceName)
 	// TODO: Cannot retrieve image id
+	data.Set("release_status", apps.ReleaseState)
+
+	objects, _, err := inst.Client.KubernetesApps.ListAppsObjects(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployedObjects := make([]map[string]interface{}, 0)
+	for _, object := range objects {
+		deployedObjects = append(deployedObjects, map[string]interface{}{
+			"kind":      object.Kind,
+			"name":      object.Name,
+			"namespace": object.NamespaceName,
+		})
+	}
+	data.Set("deployed_objects", deployedObjects)
+
 	tfTags.SetTags(ctx, data, meta, data.Id())
 
 	return nil
@@ -123,6 +169,31 @@ func resourceKubernetesAppsUpdate(ctx context.Context, rd *schema.ResourceData,
 		}
 	}()
 
+	if rd.HasChanges("image_id", "additional_params") {
+		inst := meta.(*client.Instance)
+		imageId := rd.Get("image_id").(string)
+
+		var image kubernetesapps.ImagesResponse
+		image, _, err = inst.Client.KubernetesApps.ReadImage(ctx, imageId)
+		if err != nil {
+			return
+		}
+
+		log.Printf("[INFO] Upgrading kubernetes apps %s with image %s", rd.Id(), imageId)
+		_, _, err = inst.Client.KubernetesApps.UpgradeApps(ctx, rd.Get("engine_id").(string), rd.Get("namespace").(string), rd.Id(), imageId, image.ProductGroupId, rd.Get("additional_params").(map[string]interface{}))
+		if err != nil {
+			return
+		}
+
+		if rd.Get("wait_for_ready").(bool) {
+			time.Sleep(5 * time.Second)
+			err = client.WaitForStatus(ctx, inst.Client, []string{}, []string{"deployed"}, refreshApps(ctx, meta, rd.Id(), true))
+			if err != nil {
+				return
+			}
+		}
+	}
+
 	err = tfTags.UpdateTags(ctx, rd, meta, rd.Id())
 	if err != nil {
 		return
@@ -170,3 +241,49 @@ func refreshApps(ctx context.Context, meta interface{}, id string, errorOnNotFou
 		return nil, "", fmt.Errorf("failed to read kubernetes apps(%s) status:%d", id, httpStatus)
 	}
 }
+
+// 같은 앱의 이후 버전으로 바뀌는 경우에만 in-place upgrade 하고, 다른 앱이거나 이전 버전이면 재생성한다.
+func customizeAppsImageDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if len(diff.Id()) == 0 || !diff.HasChange("image_id") {
+		return nil
+	}
+
+	inst := meta.(*client.Instance)
+	oldImageId, newImageId := diff.GetChange("image_id")
+
+	// import 한 경우 image_id 를 조회할 수 없어 state 에 비어 있으므로 비교하지 않는다.
+	if len(oldImageId.(string)) == 0 {
+		return nil
+	}
+
+	oldImage, _, err := inst.Client.KubernetesApps.ReadImage(ctx, oldImageId.(string))
+	if err != nil {
+		return err
+	}
+	newImage, _, err := inst.Client.KubernetesApps.ReadImage(ctx, newImageId.(string))
+	if err != nil {
+		return err
+	}
+
+	if !isAppsUpgrade(oldImage, newImage) {
+		return diff.ForceNew("image_id")
+	}
+	return nil
+}
+
+func isAppsUpgrade(oldImage kubernetesapps.ImagesResponse, newImage kubernetesapps.ImagesResponse) bool {
+	if len(oldImage.ImageName) == 0 || common.ExtractVersionPrefix(oldImage.ImageName) != common.ExtractVersionPrefix(newImage.ImageName) {
+		return false
+	}
+
+	oldVersion, err := version.NewVersion(oldImage.ImageAttr["display.version"])
+	if err != nil {
+		return false
+	}
+	newVersion, err := version.NewVersion(newImage.ImageAttr["display.version"])
+	if err != nil {
+		return false
+	}
+
+	return newVersion.GreaterThanOrEqual(oldVersion)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
 	tfTags "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -31,6 +32,11 @@ func ResourceKubernetesEngine() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.All(
+			validateEngineUpgrade,
+			validateEngineAddons,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -49,8 +55,10 @@ func ResourceKubernetesEngine() *schema.Resource {
 			"kubernetes_version": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Kubernetes version (Contact administrator to check supported version)",
+				Description: "Kubernetes version (Contact administrator to check supported version). Upgrades step through each intermediate minor version",
 			},
+			"upgrade_policy":     upgradePolicySchema(),
+			"autoscaler_profile": autoscalerProfileSchema(),
 			"load_balancer_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -121,7 +129,14 @@ func ResourceKubernetesEngine() *schema.Resource {
 				Optional:    true,
 				Description: "CIFS volume id",
 			},
-			"tags": tfTags.TagsSchema(),
+			"container_registry_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Container registry IDs attached to the engine. Node pools can pull images from attached registries without image pull secrets",
+			},
+			"addon": addonSchema(),
+			"tags":  tfTags.TagsSchema(),
 		},
 		Description: "Provides a K8s Engine resource.",
 	}
@@ -166,6 +181,21 @@ func createEngine(ctx context.Context, data *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 
+	err = updateEngineAutoscalerProfile(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateEngineRegistries(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateEngineAddons(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return readEngine(ctx, data, meta)
 }
 
@@ -193,6 +223,22 @@ func readEngine(ctx context.Context, data *schema.ResourceData, meta interface{}
 	data.Set("vpc_id", engine.VpcId)
 	data.Set("zone_id", engine.ZoneId)
 	data.Set("public_endpoint", engine.PublicEndpointUrl)
+
+	err = readEngineAutoscalerProfile(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = readEngineRegistries(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = readEngineAddons(ctx, data, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	tfTags.SetTags(ctx, data, meta, data.Id())
 
 	return nil
@@ -202,8 +248,15 @@ func updateEngine(ctx context.Context, data *schema.ResourceData, meta interface
 	inst := meta.(*client.Instance)
 
 	if data.HasChanges("kubernetes_version") {
-		_, _, err := inst.Client.KubernetesEngine.UpgradeEngine(ctx, data.Id(), kubernetesengine.UpgradeRequest{
-			K8sVersion: data.Get("kubernetes_version").(string),
+		err := upgradeEngine(ctx, data, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChanges("public_acl_ip_address") {
+		_, _, err := inst.Client.KubernetesEngine.UpdatePublicEndpointAccessControlEngine(ctx, data.Id(), kubernetesengine.UpdatePublicEndpointAccessControlRequest{
+			PublicAclIpAddress: data.Get("public_acl_ip_address").(string),
 		})
 
 		if err != nil {
@@ -215,20 +268,24 @@ func updateEngine(ctx context.Context, data *schema.ResourceData, meta interface
 		if err != nil {
 			return diag.FromErr(err)
 		}
-
 	}
 
-	if data.HasChanges("public_acl_ip_address") {
-		_, _, err := inst.Client.KubernetesEngine.UpdatePublicEndpointAccessControlEngine(ctx, data.Id(), kubernetesengine.UpdatePublicEndpointAccessControlRequest{
-			PublicAclIpAddress: data.Get("public_acl_ip_address").(string),
-		})
+	if data.HasChanges("autoscaler_profile") {
+		err := updateEngineAutoscalerProfile(ctx, data, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
+	if data.HasChanges("container_registry_ids") {
+		err := updateEngineRegistries(ctx, data, meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+	}
 
-		time.Sleep(10 * time.Second)
-		err = client.WaitForStatus(ctx, inst.Client, []string{"UPDATING"}, []string{"RUNNING"}, refreshEngine(ctx, meta, data.Id(), true))
+	if data.HasChanges("addon") {
+		err := updateEngineAddons(ctx, data, meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -0,0 +1,308 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/kubernetesengine"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func addonSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 63),
+					Description:  "Add-on name (e.g. metrics-server, ingress-nginx, csi-nfs, monitoring-agent)",
+				},
+				"version": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Pinned add-on version. If empty, the default version for kubernetes_version is installed and upgraded together with the engine",
+				},
+				"configuration_values": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: structure.SuppressJsonDiff,
+					Description:      "Add-on configuration values in JSON",
+				},
+				"installed_version": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Installed add-on version",
+				},
+				"addon_state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Add-on state",
+				},
+			},
+		},
+		Description: "Add-ons installed on the engine (CSI drivers, CNI options, metrics server, ingress controller, monitoring agent)",
+	}
+}
+
+// plan 단계에서 add-on 이름과 고정 버전이 kubernetes_version 과 호환되는지 검증한다.
+func validateEngineAddons(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("addon", "kubernetes_version") {
+		return nil
+	}
+
+	addons := diff.Get("addon").([]interface{})
+	if len(addons) == 0 {
+		return nil
+	}
+
+	k8sVersion := diff.Get("kubernetes_version").(string)
+	compatibleVersions, _, err := getAddonVersions(ctx, k8sVersion, meta)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool)
+	for _, a := range addons {
+		addon := a.(map[string]interface{})
+		name := addon["name"].(string)
+		if names[name] {
+			return fmt.Errorf("add-on %s is declared more than once", name)
+		}
+		names[name] = true
+
+		versions, ok := compatibleVersions[name]
+		if !ok {
+			return fmt.Errorf("add-on %s is not available for kubernetes version %s", name, k8sVersion)
+		}
+
+		pinnedVersion := addon["version"].(string)
+		if len(pinnedVersion) != 0 && !versions[pinnedVersion] {
+			return fmt.Errorf("add-on %s version %s is not compatible with kubernetes version %s", name, pinnedVersion, k8sVersion)
+		}
+	}
+
+	return nil
+}
+
+// 설정에 선언된 add-on 만 state 에 반영한다. (플랫폼이 기본 설치한 add-on 은 관리하지 않는다)
+func readEngineAddons(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	addons := data.Get("addon").([]interface{})
+	if len(addons) == 0 {
+		return nil
+	}
+
+	installedAddons, _, err := inst.Client.KubernetesEngine.GetEngineAddonList(ctx, data.Id())
+	if err != nil {
+		return err
+	}
+
+	installed := make(map[string]map[string]interface{})
+	for _, addon := range installedAddons.Contents {
+		installed[addon.AddonName] = map[string]interface{}{
+			"installed_version":    addon.AddonVersion,
+			"addon_state":          addon.AddonState,
+			"configuration_values": addon.ConfigurationValues,
+		}
+	}
+
+	addonList := make([]map[string]interface{}, 0)
+	for _, a := range addons {
+		addon := a.(map[string]interface{})
+		info, ok := installed[addon["name"].(string)]
+		if !ok {
+			continue
+		}
+
+		pinnedVersion := addon["version"].(string)
+		if len(pinnedVersion) != 0 {
+			pinnedVersion = info["installed_version"].(string)
+		}
+
+		addonList = append(addonList, map[string]interface{}{
+			"name":                 addon["name"],
+			"version":              pinnedVersion,
+			"configuration_values": info["configuration_values"],
+			"installed_version":    info["installed_version"],
+			"addon_state":          info["addon_state"],
+		})
+	}
+
+	return data.Set("addon", addonList)
+}
+
+func updateEngineAddons(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	o, n := data.GetChange("addon")
+	oldAddons := toAddonMap(o.([]interface{}))
+	newAddons := toAddonMap(n.([]interface{}))
+
+	for name := range oldAddons {
+		if _, ok := newAddons[name]; ok {
+			continue
+		}
+
+		_, _, err := inst.Client.KubernetesEngine.UninstallEngineAddon(ctx, data.Id(), name)
+		if err != nil && !common.IsDeleted(err) {
+			return err
+		}
+
+		err = waitForEngineUpdate(ctx, data.Id(), meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	var defaultVersions map[string]string
+	for name, addon := range newAddons {
+		oldAddon, exists := oldAddons[name]
+		if exists && oldAddon["version"] == addon["version"] && oldAddon["configuration_values"] == addon["configuration_values"] {
+			continue
+		}
+
+		addonVersion := addon["version"].(string)
+		if len(addonVersion) == 0 {
+			if defaultVersions == nil {
+				var err error
+				_, defaultVersions, err = getAddonVersions(ctx, data.Get("kubernetes_version").(string), meta)
+				if err != nil {
+					return err
+				}
+			}
+			addonVersion = defaultVersions[name]
+		}
+
+		var err error
+		if exists {
+			_, _, err = inst.Client.KubernetesEngine.UpdateEngineAddon(ctx, data.Id(), name, kubernetesengine.UpdateEngineAddonRequest{
+				AddonVersion:        addonVersion,
+				ConfigurationValues: addon["configuration_values"].(string),
+			})
+		} else {
+			_, _, err = inst.Client.KubernetesEngine.InstallEngineAddon(ctx, data.Id(), kubernetesengine.InstallEngineAddonRequest{
+				AddonName:           name,
+				AddonVersion:        addonVersion,
+				ConfigurationValues: addon["configuration_values"].(string),
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		err = waitForEngineUpdate(ctx, data.Id(), meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// control plane 업그레이드 단계마다 호출된다.
+// 버전을 고정하지 않은 add-on 은 해당 kubernetes 버전의 기본 버전으로 올리고,
+// 고정된 add-on 은 해당 단계와 호환되는 경우에만 고정 버전으로 맞춘다.
+func upgradeEngineAddons(ctx context.Context, data *schema.ResourceData, k8sVersion string, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	addons := data.Get("addon").([]interface{})
+	if len(addons) == 0 {
+		return nil
+	}
+
+	compatibleVersions, defaultVersions, err := getAddonVersions(ctx, k8sVersion, meta)
+	if err != nil {
+		return err
+	}
+
+	installedAddons, _, err := inst.Client.KubernetesEngine.GetEngineAddonList(ctx, data.Id())
+	if err != nil {
+		return err
+	}
+
+	installedVersions := make(map[string]string)
+	for _, addon := range installedAddons.Contents {
+		installedVersions[addon.AddonName] = addon.AddonVersion
+	}
+
+	for _, a := range addons {
+		addon := a.(map[string]interface{})
+		name := addon["name"].(string)
+
+		installedVersion, ok := installedVersions[name]
+		if !ok {
+			continue
+		}
+
+		desiredVersion := addon["version"].(string)
+		if len(desiredVersion) == 0 {
+			desiredVersion = defaultVersions[name]
+		} else if !compatibleVersions[name][desiredVersion] {
+			continue
+		}
+
+		if len(desiredVersion) == 0 || desiredVersion == installedVersion {
+			continue
+		}
+
+		log.Printf("[INFO] Kubernetes engine(%s) add-on %s upgrade : %s -> %s", data.Id(), name, installedVersion, desiredVersion)
+
+		_, _, err = inst.Client.KubernetesEngine.UpdateEngineAddon(ctx, data.Id(), name, kubernetesengine.UpdateEngineAddonRequest{
+			AddonVersion:        desiredVersion,
+			ConfigurationValues: addon["configuration_values"].(string),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upgrade add-on %s to %s : %s", name, desiredVersion, err)
+		}
+
+		err = waitForEngineUpdate(ctx, data.Id(), meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// kubernetes 버전과 호환되는 add-on 버전 목록과 add-on 별 기본 버전을 구한다.
+func getAddonVersions(ctx context.Context, k8sVersion string, meta interface{}) (map[string]map[string]bool, map[string]string, error) {
+	inst := meta.(*client.Instance)
+
+	addonVersions, _, err := inst.Client.KubernetesEngine.GetAddonVersionList(ctx, k8sVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compatibleVersions := make(map[string]map[string]bool)
+	defaultVersions := make(map[string]string)
+	for _, addonVersion := range addonVersions.Contents {
+		if _, ok := compatibleVersions[addonVersion.AddonName]; !ok {
+			compatibleVersions[addonVersion.AddonName] = make(map[string]bool)
+		}
+		compatibleVersions[addonVersion.AddonName][addonVersion.AddonVersion] = true
+		if addonVersion.DefaultVersion {
+			defaultVersions[addonVersion.AddonName] = addonVersion.AddonVersion
+		}
+	}
+
+	return compatibleVersions, defaultVersions, nil
+}
+
+func toAddonMap(list []interface{}) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+	for _, v := range list {
+		addon := v.(map[string]interface{})
+		result[addon["name"].(string)] = addon
+	}
+	return result
+}
@@ -28,7 +28,8 @@ func ResourceKubernetesAdminKubeconfig() *schema.Resource {
 			common.ToSnakeCase("kubeconfigType"):     {Type: schema.TypeString, Required: true, ForceNew: true, Description: "kubeconfig Type"},
 			common.ToSnakeCase("KubeConfig"):         {Type: schema.TypeString, Computed: true, ForceNew: true, Description: "Administrator KubeConfig"},
 		},
-		Description: "Provides Kubernetes Engine Administrator Kubeconfig",
+		Description:        "Provides Kubernetes Engine Administrator Kubeconfig",
+		DeprecationMessage: "Use the samsungcloudplatform_kubernetes_kubeconfig data source with admin = true instead. This resource keeps the kubeconfig in state and never refreshes it.",
 	}
 }
 
@@ -0,0 +1,116 @@
+package kubernetes
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/kubernetesengine"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var autoscalerDurationPattern = regexp.MustCompile(`^[0-9]+(s|m|h)$`)
+
+func autoscalerProfileSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"expander": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"random", "most-pods", "least-waste", "priority"}, false)),
+					Description:      "Expander used to choose the node pool to scale out (random|most-pods|least-waste|priority)",
+				},
+				"scale_down_delay_after_add": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(autoscalerDurationPattern, "must be a duration such as 10m")),
+					Description:      "How long after scale out that scale down evaluation resumes (e.g. 10m)",
+				},
+				"scale_down_unneeded_time": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(autoscalerDurationPattern, "must be a duration such as 10m")),
+					Description:      "How long a node should be unneeded before it is eligible for scale down (e.g. 10m)",
+				},
+				"scale_down_utilization_threshold": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.FloatBetween(0, 1),
+					Description:  "Node utilization level below which a node can be considered for scale down (0 ~ 1)",
+				},
+			},
+		},
+		Description: "Cluster autoscaler profile applied to node pools with auto scale enabled",
+	}
+}
+
+func readEngineAutoscalerProfile(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	profile, _, err := inst.Client.KubernetesEngine.GetEngineAutoscalerProfile(ctx, data.Id())
+	if err != nil {
+		return err
+	}
+
+	return data.Set("autoscaler_profile", []interface{}{
+		map[string]interface{}{
+			"expander":                         profile.Expander,
+			"scale_down_delay_after_add":       profile.ScaleDownDelayAfterAdd,
+			"scale_down_unneeded_time":         profile.ScaleDownUnneededTime,
+			"scale_down_utilization_threshold": profile.ScaleDownUtilizationThreshold,
+		},
+	})
+}
+
+func updateEngineAutoscalerProfile(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	profiles := data.Get("autoscaler_profile").([]interface{})
+	if len(profiles) == 0 || profiles[0] == nil {
+		return nil
+	}
+
+	// 설정하지 않은 항목은 플랫폼의 현재 값(기본값)을 그대로 보낸다.
+	current, _, err := inst.Client.KubernetesEngine.GetEngineAutoscalerProfile(ctx, data.Id())
+	if err != nil {
+		return err
+	}
+
+	request := kubernetesengine.UpdateEngineAutoscalerProfileRequest{
+		Expander:                      current.Expander,
+		ScaleDownDelayAfterAdd:        current.ScaleDownDelayAfterAdd,
+		ScaleDownUnneededTime:         current.ScaleDownUnneededTime,
+		ScaleDownUtilizationThreshold: current.ScaleDownUtilizationThreshold,
+	}
+	if v, ok := data.GetOk("autoscaler_profile.0.expander"); ok {
+		request.Expander = v.(string)
+	}
+	if v, ok := data.GetOk("autoscaler_profile.0.scale_down_delay_after_add"); ok {
+		request.ScaleDownDelayAfterAdd = v.(string)
+	}
+	if v, ok := data.GetOk("autoscaler_profile.0.scale_down_unneeded_time"); ok {
+		request.ScaleDownUnneededTime = v.(string)
+	}
+	if v, ok := data.GetOk("autoscaler_profile.0.scale_down_utilization_threshold"); ok {
+		request.ScaleDownUtilizationThreshold = v.(float64)
+	}
+
+	_, _, err = inst.Client.KubernetesEngine.UpdateEngineAutoscalerProfile(ctx, data.Id(), request)
+	if err != nil {
+		return err
+	}
+
+	time.Sleep(10 * time.Second)
+	return client.WaitForStatus(ctx, inst.Client, []string{"UPDATING"}, []string{"RUNNING"}, refreshEngine(ctx, meta, data.Id(), true))
+}
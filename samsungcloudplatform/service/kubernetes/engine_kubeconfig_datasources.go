@@ -0,0 +1,235 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	samsungcloudplatform.RegisterDataSource("Kubernetes", "samsungcloudplatform_kubernetes_kubeconfig", DatasourceKubeConfig())
+}
+
+func DatasourceKubeConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: engineKubeConfig,
+		Schema: map[string]*schema.Schema{
+			"kubernetes_engine_id": {Type: schema.TypeString, Required: true, Description: "Engine Id"},
+			"kubeconfig_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "private",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"private", "public"}, false)),
+				Description:      "kubeconfig Type (private|public)",
+			},
+			"admin": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to download the administrator kubeconfig instead of the user kubeconfig",
+			},
+			"host":                   {Type: schema.TypeString, Computed: true, Description: "Kubernetes API server endpoint"},
+			"cluster_name":           {Type: schema.TypeString, Computed: true, Description: "Cluster name of the current context"},
+			"user_name":              {Type: schema.TypeString, Computed: true, Description: "User name of the current context"},
+			"cluster_ca_certificate": {Type: schema.TypeString, Computed: true, Description: "PEM encoded cluster CA certificate"},
+			"client_certificate":     {Type: schema.TypeString, Computed: true, Description: "PEM encoded client certificate"},
+			"client_key":             {Type: schema.TypeString, Computed: true, Sensitive: true, Description: "PEM encoded client private key"},
+			"token":                  {Type: schema.TypeString, Computed: true, Sensitive: true, Description: "Bearer token"},
+			"expires_at":             {Type: schema.TypeString, Computed: true, Description: "Expiry of the client certificate or token (RFC3339). Empty if the credential does not expire"},
+			"kube_config":            {Type: schema.TypeString, Computed: true, Sensitive: true, Description: "Raw kubeconfig"},
+		},
+		Description: "Provides Kubernetes Engine kubeconfig credentials for the kubernetes and helm providers. The kubeconfig is downloaded on every read so rotated credentials are picked up.",
+	}
+}
+
+func engineKubeConfig(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	engineId := rd.Get("kubernetes_engine_id").(string)
+	kubeconfigType := rd.Get("kubeconfig_type").(string)
+
+	var response string
+	var err error
+	if rd.Get("admin").(bool) {
+		response, _, err = inst.Client.KubernetesEngine.GetKubeConfig(ctx, engineId, kubeconfigType)
+	} else {
+		response, _, err = inst.Client.KubernetesEngine.GetUserKubeConfig(ctx, engineId, kubeconfigType)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	credential, err := parseKubeConfig(response)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(uuid.NewV4().String())
+	rd.Set("host", credential.Host)
+	rd.Set("cluster_name", credential.ClusterName)
+	rd.Set("user_name", credential.UserName)
+	rd.Set("cluster_ca_certificate", credential.ClusterCaCertificate)
+	rd.Set("client_certificate", credential.ClientCertificate)
+	rd.Set("client_key", credential.ClientKey)
+	rd.Set("token", credential.Token)
+	rd.Set("expires_at", credential.ExpiresAt)
+	rd.Set("kube_config", response)
+
+	return nil
+}
+
+type kubeConfigCredential struct {
+	Host                 string
+	ClusterName          string
+	UserName             string
+	ClusterCaCertificate string
+	ClientCertificate    string
+	ClientKey            string
+	Token                string
+	ExpiresAt            string
+}
+
+type kubeConfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// current-context 기준으로 cluster / user 정보를 찾는다. (current-context 가 없으면 첫 번째 context 사용)
+func parseKubeConfig(kubeConfig string) (kubeConfigCredential, error) {
+	var config kubeConfigFile
+	if err := yaml.Unmarshal([]byte(kubeConfig), &config); err != nil {
+		return kubeConfigCredential{}, fmt.Errorf("failed to parse kubeconfig : %v", err)
+	}
+	if len(config.Contexts) == 0 {
+		return kubeConfigCredential{}, fmt.Errorf("no context found in kubeconfig")
+	}
+
+	contextIndex := 0
+	for i, c := range config.Contexts {
+		if c.Name == config.CurrentContext {
+			contextIndex = i
+			break
+		}
+	}
+	credential := kubeConfigCredential{
+		ClusterName: config.Contexts[contextIndex].Context.Cluster,
+		UserName:    config.Contexts[contextIndex].Context.User,
+	}
+
+	var err error
+	for _, c := range config.Clusters {
+		if c.Name != credential.ClusterName {
+			continue
+		}
+		credential.Host = c.Cluster.Server
+		credential.ClusterCaCertificate, err = decodeKubeConfigData(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return kubeConfigCredential{}, err
+		}
+	}
+	if len(credential.Host) == 0 {
+		return kubeConfigCredential{}, fmt.Errorf("cluster %s not found in kubeconfig", credential.ClusterName)
+	}
+
+	for _, u := range config.Users {
+		if u.Name != credential.UserName {
+			continue
+		}
+		credential.Token = u.User.Token
+		credential.ClientCertificate, err = decodeKubeConfigData(u.User.ClientCertificateData)
+		if err != nil {
+			return kubeConfigCredential{}, err
+		}
+		credential.ClientKey, err = decodeKubeConfigData(u.User.ClientKeyData)
+		if err != nil {
+			return kubeConfigCredential{}, err
+		}
+	}
+
+	if len(credential.ClientCertificate) != 0 {
+		credential.ExpiresAt, err = getCertificateExpiry(credential.ClientCertificate)
+		if err != nil {
+			return kubeConfigCredential{}, err
+		}
+	} else if len(credential.Token) != 0 {
+		credential.ExpiresAt = getTokenExpiry(credential.Token)
+	}
+
+	return credential, nil
+}
+
+func decodeKubeConfigData(data string) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode kubeconfig data : %v", err)
+	}
+	return string(decoded), nil
+}
+
+func getCertificateExpiry(certificate string) (string, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return "", fmt.Errorf("invalid client certificate in kubeconfig")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	return cert.NotAfter.UTC().Format(time.RFC3339), nil
+}
+
+// JWT 가 아닌 token 이거나 exp 가 없으면 만료 시간은 비워둔다.
+func getTokenExpiry(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return ""
+	}
+	return time.Unix(claims.Exp, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,68 @@
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readEngineRegistries(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	registries, _, err := inst.Client.KubernetesEngine.GetEngineRegistryList(ctx, data.Id())
+	if err != nil {
+		return err
+	}
+
+	registryIds := make([]string, 0)
+	for _, registry := range registries.Contents {
+		registryIds = append(registryIds, registry.RegistryId)
+	}
+
+	return data.Set("container_registry_ids", registryIds)
+}
+
+// 연결된 registry 는 engine 이 image pull secret 을 직접 관리하므로 node pool 에서 별도 secret 없이 pull 할 수 있다.
+func updateEngineRegistries(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	o, n := data.GetChange("container_registry_ids")
+	oldIds := o.(*schema.Set)
+	newIds := n.(*schema.Set)
+
+	for _, registryId := range common.ToStringList(oldIds.Difference(newIds).List()) {
+		_, _, err := inst.Client.KubernetesEngine.DetachEngineRegistry(ctx, data.Id(), registryId)
+		if err != nil && !common.IsDeleted(err) {
+			return err
+		}
+
+		err = waitForEngineUpdate(ctx, data.Id(), meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, registryId := range common.ToStringList(newIds.Difference(oldIds).List()) {
+		_, _, err := inst.Client.KubernetesEngine.AttachEngineRegistry(ctx, data.Id(), registryId)
+		if err != nil {
+			return err
+		}
+
+		err = waitForEngineUpdate(ctx, data.Id(), meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func waitForEngineUpdate(ctx context.Context, id string, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	time.Sleep(10 * time.Second)
+	return client.WaitForStatus(ctx, inst.Client, []string{"UPDATING"}, []string{"RUNNING"}, refreshEngine(ctx, meta, id, true))
+}
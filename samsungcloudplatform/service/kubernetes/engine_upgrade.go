@@ -0,0 +1,370 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/kubernetesengine"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/image"
+	kubernetesengine2 "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/kubernetes-engine2"
+	"github.com/antihax/optional"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func upgradePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"upgrade_node_pools": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Upgrade node pools to the matching image after each control plane step. Update image_id of the managed node pools accordingly. The settings of this policy apply to every node pool and take precedence over upgrade_settings of the node pools",
+				},
+				"max_surge": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 10),
+					Description:  "Number of nodes temporarily added to each node pool (without auto scale) while it is upgraded",
+				},
+				"drain_timeout_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 1440),
+					Description:  "Maximum time in minutes to wait for a node to drain before it is replaced. 0 uses the platform default",
+				},
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 10),
+					Description:  "Maximum number of nodes of each node pool upgraded at the same time. 0 uses the platform default",
+				},
+				"respect_pdb": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Respect PodDisruptionBudgets while draining nodes",
+				},
+			},
+		},
+		Description: "Kubernetes version upgrade policy",
+	}
+}
+
+// 현재 버전에서 목표 버전까지 minor 버전을 하나씩 올리는 업그레이드 경로를 구한다.
+// 중간 minor 버전은 지원 버전 중 가장 높은 patch 버전을 사용한다.
+func getEngineUpgradePath(current string, target string, supportedVersions []string) ([]string, error) {
+	currentVersion, err := version.NewVersion(current)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubernetes version %s : %s", current, err)
+	}
+	targetVersion, err := version.NewVersion(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubernetes version %s : %s", target, err)
+	}
+
+	if targetVersion.Equal(currentVersion) {
+		return nil, nil
+	}
+	if targetVersion.LessThan(currentVersion) {
+		return nil, fmt.Errorf("cannot downgrade kubernetes version from %s to %s", current, target)
+	}
+
+	// minor 버전별 가장 높은 patch 버전
+	latestByMinor := make(map[int]*version.Version)
+	targetFound := false
+	for _, v := range supportedVersions {
+		supported, err := version.NewVersion(v)
+		if err != nil {
+			continue
+		}
+		if supported.Equal(targetVersion) {
+			targetFound = true
+		}
+		segments := supported.Segments()
+		if segments[0] != currentVersion.Segments()[0] {
+			continue
+		}
+		if latest, ok := latestByMinor[segments[1]]; !ok || supported.GreaterThan(latest) {
+			latestByMinor[segments[1]] = supported
+		}
+	}
+	if !targetFound {
+		return nil, fmt.Errorf("kubernetes version %s is not supported (supported : %s)", target, strings.Join(supportedVersions, ", "))
+	}
+	if targetVersion.Segments()[0] != currentVersion.Segments()[0] {
+		return nil, fmt.Errorf("cannot upgrade kubernetes version from %s to %s : major version upgrade is not supported", current, target)
+	}
+
+	var path []string
+	for minor := currentVersion.Segments()[1] + 1; minor < targetVersion.Segments()[1]; minor++ {
+		step, ok := latestByMinor[minor]
+		if !ok {
+			return nil, fmt.Errorf("no upgrade path from %s to %s : no supported version for %d.%d", current, target, currentVersion.Segments()[0], minor)
+		}
+		path = append(path, step.Original())
+	}
+	path = append(path, target)
+
+	return path, nil
+}
+
+func getSupportedEngineVersions(ctx context.Context, meta interface{}) ([]string, error) {
+	inst := meta.(*client.Instance)
+
+	var versions []string
+	var page int32 = 0
+	for {
+		responses, _, err := inst.Client.KubernetesEngine.GetEngineVersionList(ctx, &kubernetesengine2.K8sTemplateV2ApiListKubernetesVersionV21Opts{
+			Page: optional.NewInt32(page),
+			Size: optional.NewInt32(100),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range responses.Contents {
+			versions = append(versions, c.K8sVersion)
+		}
+
+		if len(responses.Contents) == 0 || int64(len(versions)) >= int64(responses.TotalCount) {
+			break
+		}
+		page++
+	}
+
+	sort.Strings(versions)
+	return versions, nil
+}
+
+// plan 단계에서 업그레이드 경로를 검증한다.
+func validateEngineUpgrade(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if len(diff.Id()) == 0 || !diff.HasChange("kubernetes_version") {
+		return nil
+	}
+
+	oldVersion, newVersion := diff.GetChange("kubernetes_version")
+	if len(oldVersion.(string)) == 0 {
+		return nil
+	}
+
+	supportedVersions, err := getSupportedEngineVersions(ctx, meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = getEngineUpgradePath(oldVersion.(string), newVersion.(string), supportedVersions)
+	return err
+}
+
+// 업그레이드 경로를 따라 control plane 을 한 단계씩 올리고, 각 단계마다 add-on 과 (설정된 경우) node pool 도 함께 올린다.
+// 실패한 단계에서 중단하며, 마지막으로 성공한 버전을 state 에 남긴다.
+func upgradeEngine(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	oldVersion, newVersion := data.GetChange("kubernetes_version")
+	currentVersion := oldVersion.(string)
+
+	supportedVersions, err := getSupportedEngineVersions(ctx, meta)
+	if err != nil {
+		return err
+	}
+
+	path, err := getEngineUpgradePath(currentVersion, newVersion.(string), supportedVersions)
+	if err != nil {
+		return err
+	}
+
+	// engine 업그레이드에서는 node pool 의 upgrade_settings 대신 upgrade_policy 설정을 모든 node pool 에 적용한다.
+	upgradeNodePools := false
+	settings := nodePoolUpgradeSettings{
+		strategy:   NodePoolUpgradeStrategySurge,
+		respectPdb: true,
+	}
+	if policies := data.Get("upgrade_policy").([]interface{}); len(policies) != 0 && policies[0] != nil {
+		policy := policies[0].(map[string]interface{})
+		upgradeNodePools = policy["upgrade_node_pools"].(bool)
+		settings.maxSurge = policy["max_surge"].(int)
+		settings.maxUnavailable = policy["max_unavailable"].(int)
+		settings.drainTimeoutMinutes = policy["drain_timeout_minutes"].(int)
+		settings.respectPdb = policy["respect_pdb"].(bool)
+	}
+
+	for i, step := range path {
+		log.Printf("[INFO] Kubernetes engine(%s) upgrade step %d/%d : %s -> %s", data.Id(), i+1, len(path), currentVersion, step)
+
+		_, _, err = inst.Client.KubernetesEngine.UpgradeEngine(ctx, data.Id(), kubernetesengine.UpgradeRequest{
+			K8sVersion: step,
+		})
+		if err == nil {
+			time.Sleep(10 * time.Second)
+			err = client.WaitForStatus(ctx, inst.Client, []string{"UPDATING"}, []string{"RUNNING"}, refreshEngine(ctx, meta, data.Id(), true))
+		}
+		if err != nil {
+			data.Set("kubernetes_version", currentVersion)
+			return fmt.Errorf("kubernetes engine upgrade stopped at step %d/%d (%s -> %s) : %s", i+1, len(path), currentVersion, step, err)
+		}
+		currentVersion = step
+
+		err = upgradeEngineAddons(ctx, data, step, meta)
+		if err != nil {
+			data.Set("kubernetes_version", currentVersion)
+			return fmt.Errorf("kubernetes engine upgrade stopped at step %d/%d (add-ons to %s) : %s", i+1, len(path), step, err)
+		}
+
+		if upgradeNodePools {
+			err = upgradeEngineNodePools(ctx, data.Id(), step, settings, meta)
+			if err != nil {
+				data.Set("kubernetes_version", currentVersion)
+				return fmt.Errorf("kubernetes engine upgrade stopped at step %d/%d (node pools to %s) : %s", i+1, len(path), step, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func upgradeEngineNodePools(ctx context.Context, engineId string, k8sVersion string, settings nodePoolUpgradeSettings, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	engine, _, err := inst.Client.KubernetesEngine.ReadEngine(ctx, engineId)
+	if err != nil {
+		return err
+	}
+
+	nodePools, _, err := inst.Client.KubernetesEngine.GetNodePoolList(ctx, engineId, &kubernetesengine2.NodePoolV2ApiListNodePoolsV2Opts{
+		NodePoolName: optional.String{},
+		CreatedBy:    optional.String{},
+		Page:         optional.NewInt32(0),
+		Size:         optional.NewInt32(100),
+		Sort:         optional.String{},
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, c := range nodePools.Contents {
+		nodePool, _, err := inst.Client.KubernetesEngine.ReadNodePool(ctx, engineId, c.NodePoolId)
+		if err != nil {
+			return err
+		}
+
+		imageId, err := findNodePoolUpgradeImage(ctx, engine.ZoneId, nodePool.ImageId, k8sVersion, meta)
+		if err != nil {
+			return fmt.Errorf("node pool %s : %s", nodePool.NodePoolName, err)
+		}
+		if len(imageId) == 0 {
+			log.Printf("[INFO] Kubernetes node pool %s is already at %s", nodePool.NodePoolName, k8sVersion)
+			continue
+		}
+
+		log.Printf("[INFO] Kubernetes node pool upgrade %d/%d : %s -> %s", i+1, len(nodePools.Contents), nodePool.NodePoolName, k8sVersion)
+
+		// auto scale 이 아닌 node pool 은 upgrade 동안 노드를 max_surge 만큼 늘려둔다.
+		surge := settings.maxSurge > 0 && !*nodePool.AutoScale
+		if surge {
+			err = resizeNodePool(ctx, engineId, c.NodePoolId, nodePool, nodePool.DesiredNodeCount+int32(settings.maxSurge), meta)
+			if err != nil {
+				return fmt.Errorf("node pool %s : %s", nodePool.NodePoolName, err)
+			}
+		}
+
+		_, _, err = inst.Client.KubernetesEngine.UpgradeNodePool(ctx, engineId, c.NodePoolId, kubernetesengine.NodePoolUgradeRequest{
+			UpgradeImageId:      imageId,
+			DrainTimeoutMinutes: int32(settings.drainTimeoutMinutes),
+			MaxUnavailable:      int32(settings.maxUnavailable),
+			RespectPdb:          settings.respectPdb,
+		})
+		if err != nil {
+			return fmt.Errorf("node pool %s : %s", nodePool.NodePoolName, err)
+		}
+		time.Sleep(5 * time.Second)
+
+		err = client.WaitForStatus(ctx, inst.Client, []string{}, []string{"Running"}, refreshNodePool(ctx, meta, engineId, c.NodePoolId, true))
+		if err != nil {
+			return fmt.Errorf("node pool %s : %s", nodePool.NodePoolName, err)
+		}
+
+		if surge {
+			err = resizeNodePool(ctx, engineId, c.NodePoolId, nodePool, nodePool.DesiredNodeCount, meta)
+			if err != nil {
+				return fmt.Errorf("node pool %s : %s", nodePool.NodePoolName, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resizeNodePool(ctx context.Context, engineId string, nodePoolId string, nodePool kubernetesengine2.NodePoolV2Response, desiredNodeCount int32, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	_, _, err := inst.Client.KubernetesEngine.UpdateNodePool(ctx, engineId, nodePoolId, kubernetesengine.NodePoolUpdateRequest{
+		AutoRecovery:     *nodePool.AutoRecovery,
+		AutoScale:        *nodePool.AutoScale,
+		DesiredNodeCount: desiredNodeCount,
+		MaxNodeCount:     nodePool.MaxNodeCount,
+		MinNodeCount:     nodePool.MinNodeCount,
+	})
+	if err != nil {
+		return err
+	}
+	time.Sleep(5 * time.Second)
+
+	return client.WaitForStatus(ctx, inst.Client, []string{}, []string{"Running"}, refreshNodePool(ctx, meta, engineId, nodePoolId, true))
+}
+
+// 현재 이미지와 image type / OS / product 가 같고 k8s minor 버전이 목표 버전과 같은 표준 이미지를 찾는다.
+// 이미 목표 버전인 경우 빈 문자열을 반환한다.
+func findNodePoolUpgradeImage(ctx context.Context, zoneId string, currentImageId string, k8sVersion string, meta interface{}) (string, error) {
+	inst := meta.(*client.Instance)
+
+	imageType, osType, currentK8sVersion, osVersion, productId, err := getImageInfo(ctx, currentImageId, meta)
+	if err != nil {
+		return "", err
+	}
+	if getK8sMinorVersion(currentK8sVersion) == getK8sMinorVersion(k8sVersion) {
+		return "", nil
+	}
+
+	standardImages, err := inst.Client.Image.GetStandardImageList(ctx, zoneId, image.ActiveState, common.ServicedGroupContainer, common.ServicedForKubernetesEngineVm)
+	if err != nil {
+		return "", err
+	}
+
+	for _, c := range standardImages.Contents {
+		if c.ImageType != imageType || c.OsType != osType || c.Properties["os.version"] != osVersion {
+			continue
+		}
+		if len(c.Products) == 0 || c.Products[0].ProductId != productId {
+			continue
+		}
+		if getK8sMinorVersion(c.Properties["k8s.version"]) == getK8sMinorVersion(k8sVersion) {
+			return c.ImageId, nil
+		}
+	}
+
+	return "", fmt.Errorf("no image found for kubernetes version %s (image type : %s, os type : %s)", k8sVersion, imageType, osType)
+}
+
+func getK8sMinorVersion(k8sVersion string) string {
+	segments := strings.Split(strings.TrimPrefix(k8sVersion, "v"), ".")
+	if len(segments) < 2 {
+		return k8sVersion
+	}
+	return segments[0] + "." + segments[1]
+}
@@ -2,6 +2,11 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
 	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
@@ -9,8 +14,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
 )
 
+const (
+	namespaceResourceQuotaName = "resource-quota"
+	namespaceLimitRangeName    = "limit-range"
+)
+
+var quantityPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(m|k|Ki|M|Mi|G|Gi|T|Ti|P|Pi|E|Ei)?$`)
+
+var quantitySuffixes = map[string]float64{
+	"":   1,
+	"m":  1e-3,
+	"k":  1e3,
+	"M":  1e6,
+	"G":  1e9,
+	"T":  1e12,
+	"P":  1e15,
+	"E":  1e18,
+	"Ki": 1 << 10,
+	"Mi": 1 << 20,
+	"Gi": 1 << 30,
+	"Ti": 1 << 40,
+	"Pi": 1 << 50,
+	"Ei": 1 << 60,
+}
+
+type namespaceResourceQuotaObject struct {
+	Spec struct {
+		Hard map[string]string `yaml:"hard"`
+	} `yaml:"spec"`
+}
+
+type namespaceLimitRangeObject struct {
+	Spec struct {
+		Limits []struct {
+			Type           string            `yaml:"type"`
+			Default        map[string]string `yaml:"default"`
+			DefaultRequest map[string]string `yaml:"defaultRequest"`
+			Max            map[string]string `yaml:"max"`
+		} `yaml:"limits"`
+	} `yaml:"spec"`
+}
+
 func init() {
 	samsungcloudplatform.RegisterResource("Kubernetes", "samsungcloudplatform_kubernetes_namespace", ResourceKubernetesNamespace())
 }
@@ -19,6 +66,7 @@ func ResourceKubernetesNamespace() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: createNamespace,
 		ReadContext:   readNamespace,
+		UpdateContext: updateNamespace,
 		DeleteContext: deleteNamespace,
 
 		Importer: &schema.ResourceImporter{
@@ -39,24 +87,106 @@ func ResourceKubernetesNamespace() *schema.Resource {
 				ForceNew:    true,
 				Description: "ID of scp_kubernetes_engine resource",
 			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Namespace labels",
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Namespace annotations",
+			},
+			"resource_quota": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"requests_cpu":             quantitySchema("Sum of CPU requests (e.g. 4, 500m)"),
+						"requests_memory":          quantitySchema("Sum of memory requests (e.g. 8Gi)"),
+						"limits_cpu":               quantitySchema("Sum of CPU limits (e.g. 8)"),
+						"limits_memory":            quantitySchema("Sum of memory limits (e.g. 16Gi)"),
+						"requests_storage":         quantitySchema("Sum of storage requests of persistent volume claims (e.g. 100Gi)"),
+						"pods":                     {Type: schema.TypeInt, Optional: true, ValidateFunc: validation.IntAtLeast(0), Description: "Maximum number of pods"},
+						"persistent_volume_claims": {Type: schema.TypeInt, Optional: true, ValidateFunc: validation.IntAtLeast(0), Description: "Maximum number of persistent volume claims"},
+					},
+				},
+				Description: "Resource quota of the namespace",
+			},
+			"limit_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_cpu":            quantitySchema("Default CPU limit of a container"),
+						"default_memory":         quantitySchema("Default memory limit of a container"),
+						"default_request_cpu":    quantitySchema("Default CPU request of a container"),
+						"default_request_memory": quantitySchema("Default memory request of a container"),
+						"max_cpu":                quantitySchema("Maximum CPU of a container"),
+						"max_memory":             quantitySchema("Maximum memory of a container"),
+					},
+				},
+				Description: "Container limit range of the namespace",
+			},
 		},
 		Description: "Provides a K8s Namespace resource.",
 	}
 }
 
+func quantitySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(quantityPattern, "must be a kubernetes quantity such as 500m, 2 or 4Gi")),
+		Description:      description,
+	}
+}
+
 func createNamespace(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	inst := meta.(*client.Instance)
 	engineId := data.Get("engine_id").(string)
 	name := data.Get("name").(string)
 
-	_, _, err := inst.Client.Kubernetes.CreateNamespace(ctx, engineId, name)
+	namespaceYaml, err := getNamespaceYaml(name, data.Get("labels").(map[string]interface{}), data.Get("annotations").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, _, err = inst.Client.Kubernetes.CreateObject(ctx, engineId, namespaceYaml)
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	data.SetId(name)
+
+	if quota := getNamespaceBlock(data.Get("resource_quota")); quota != nil {
+		quotaYaml, err := getResourceQuotaYaml(name, quota)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, _, err = inst.Client.Kubernetes.CreateObject(ctx, engineId, quotaYaml)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if limit := getNamespaceBlock(data.Get("limit_range")); limit != nil {
+		limitYaml, err := getLimitRangeYaml(name, limit)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, _, err = inst.Client.Kubernetes.CreateObject(ctx, engineId, limitYaml)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return readNamespace(ctx, data, meta)
 }
 
@@ -84,9 +214,169 @@ func readNamespace(ctx context.Context, data *schema.ResourceData, meta interfac
 	}
 
 	data.Set("name", ns.NamespaceName)
+	data.Set("labels", removeSystemKeys(ns.Labels))
+	data.Set("annotations", removeSystemKeys(ns.Annotations))
+
+	quota, err := readNamespaceResourceQuota(ctx, engineId, name, getNamespaceBlock(data.Get("resource_quota")), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	data.Set("resource_quota", quota)
+
+	limit, err := readNamespaceLimitRange(ctx, engineId, name, getNamespaceBlock(data.Get("limit_range")), meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	data.Set("limit_range", limit)
+
 	return nil
 }
 
+// resource quota 를 조회한다. 없으면 빈 목록을 돌려준다.
+func readNamespaceResourceQuota(ctx context.Context, engineId string, namespace string, current map[string]interface{}, meta interface{}) ([]interface{}, error) {
+	inst := meta.(*client.Instance)
+
+	quotaYaml, _, err := inst.Client.Kubernetes.ReadObject(ctx, engineId, "ResourceQuota", namespace, namespaceResourceQuotaName)
+	if err != nil {
+		if common.IsDeleted(err) {
+			return []interface{}{}, nil
+		}
+		return nil, err
+	}
+
+	var quota namespaceResourceQuotaObject
+	if err := yaml.Unmarshal([]byte(quotaYaml), &quota); err != nil {
+		return nil, fmt.Errorf("failed to parse resource quota : %v", err)
+	}
+
+	hard := quota.Spec.Hard
+	pods, _ := strconv.Atoi(hard["pods"])
+	persistentVolumeClaims, _ := strconv.Atoi(hard["persistentvolumeclaims"])
+
+	return []interface{}{
+		map[string]interface{}{
+			"requests_cpu":             getReadQuantity(hard["requests.cpu"], current, "requests_cpu"),
+			"requests_memory":          getReadQuantity(hard["requests.memory"], current, "requests_memory"),
+			"limits_cpu":               getReadQuantity(hard["limits.cpu"], current, "limits_cpu"),
+			"limits_memory":            getReadQuantity(hard["limits.memory"], current, "limits_memory"),
+			"requests_storage":         getReadQuantity(hard["requests.storage"], current, "requests_storage"),
+			"pods":                     pods,
+			"persistent_volume_claims": persistentVolumeClaims,
+		},
+	}, nil
+}
+
+// container limit range 를 조회한다. 없으면 빈 목록을 돌려준다.
+func readNamespaceLimitRange(ctx context.Context, engineId string, namespace string, current map[string]interface{}, meta interface{}) ([]interface{}, error) {
+	inst := meta.(*client.Instance)
+
+	limitYaml, _, err := inst.Client.Kubernetes.ReadObject(ctx, engineId, "LimitRange", namespace, namespaceLimitRangeName)
+	if err != nil {
+		if common.IsDeleted(err) {
+			return []interface{}{}, nil
+		}
+		return nil, err
+	}
+
+	var limitRange namespaceLimitRangeObject
+	if err := yaml.Unmarshal([]byte(limitYaml), &limitRange); err != nil {
+		return nil, fmt.Errorf("failed to parse limit range : %v", err)
+	}
+
+	for _, limit := range limitRange.Spec.Limits {
+		if limit.Type != "Container" {
+			continue
+		}
+		return []interface{}{
+			map[string]interface{}{
+				"default_cpu":            getReadQuantity(limit.Default["cpu"], current, "default_cpu"),
+				"default_memory":         getReadQuantity(limit.Default["memory"], current, "default_memory"),
+				"default_request_cpu":    getReadQuantity(limit.DefaultRequest["cpu"], current, "default_request_cpu"),
+				"default_request_memory": getReadQuantity(limit.DefaultRequest["memory"], current, "default_request_memory"),
+				"max_cpu":                getReadQuantity(limit.Max["cpu"], current, "max_cpu"),
+				"max_memory":             getReadQuantity(limit.Max["memory"], current, "max_memory"),
+			},
+		}, nil
+	}
+
+	return []interface{}{}, nil
+}
+
+// kubernetes 는 quantity 를 정규화해서 돌려주므로 (e.g. 0.5 -> 500m) 값이 같으면 설정에 적힌 표기를 유지한다.
+func getReadQuantity(value string, current map[string]interface{}, key string) string {
+	if current != nil {
+		if currentValue, ok := current[key].(string); ok && isSameQuantity(currentValue, value) {
+			return currentValue
+		}
+	}
+	return value
+}
+
+func isSameQuantity(a string, b string) bool {
+	if a == b {
+		return true
+	}
+	aValue, aOk := parseQuantity(a)
+	bValue, bOk := parseQuantity(b)
+	if !aOk || !bOk {
+		return false
+	}
+	return math.Abs(aValue-bValue) <= 1e-9*math.Max(math.Abs(aValue), math.Abs(bValue))
+}
+
+func parseQuantity(quantity string) (float64, bool) {
+	if !quantityPattern.MatchString(quantity) {
+		return 0, false
+	}
+	number := strings.TrimRight(quantity, "mkKiMGTPE")
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, false
+	}
+	multiplier, ok := quantitySuffixes[quantity[len(number):]]
+	if !ok {
+		return 0, false
+	}
+	return value * multiplier, true
+}
+
+func updateNamespace(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+	engineId := data.Get("engine_id").(string)
+	name := data.Id()
+
+	if data.HasChanges("labels", "annotations") {
+		namespaceYaml, err := getNamespaceYaml(name, data.Get("labels").(map[string]interface{}), data.Get("annotations").(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, _, err = inst.Client.Kubernetes.UpdateObject(ctx, engineId, namespaceYaml)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChanges("resource_quota") {
+		err := updateNamespaceObject(ctx, data, meta, "resource_quota", func(quota map[string]interface{}) (string, error) {
+			return getResourceQuotaYaml(name, quota)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChanges("limit_range") {
+		err := updateNamespaceObject(ctx, data, meta, "limit_range", func(limit map[string]interface{}) (string, error) {
+			return getLimitRangeYaml(name, limit)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readNamespace(ctx, data, meta)
+}
+
 func deleteNamespace(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	inst := meta.(*client.Instance)
 	engineId := data.Get("engine_id").(string)
@@ -99,3 +389,151 @@ func deleteNamespace(ctx context.Context, data *schema.ResourceData, meta interf
 
 	return nil
 }
+
+// block 이 추가되면 생성, 제거되면 삭제, 변경되면 수정한다.
+func updateNamespaceObject(ctx context.Context, data *schema.ResourceData, meta interface{}, key string, toYaml func(map[string]interface{}) (string, error)) error {
+	inst := meta.(*client.Instance)
+	engineId := data.Get("engine_id").(string)
+
+	oldValue, newValue := data.GetChange(key)
+	oldBlock := getNamespaceBlock(oldValue)
+	newBlock := getNamespaceBlock(newValue)
+
+	if newBlock == nil {
+		objectYaml, err := toYaml(oldBlock)
+		if err != nil {
+			return err
+		}
+		_, err = inst.Client.Kubernetes.DeleteObject(ctx, engineId, objectYaml)
+		if err != nil && !common.IsDeleted(err) {
+			return err
+		}
+		return nil
+	}
+
+	objectYaml, err := toYaml(newBlock)
+	if err != nil {
+		return err
+	}
+	if oldBlock == nil {
+		_, _, err = inst.Client.Kubernetes.CreateObject(ctx, engineId, objectYaml)
+	} else {
+		_, _, err = inst.Client.Kubernetes.UpdateObject(ctx, engineId, objectYaml)
+	}
+	return err
+}
+
+func getNamespaceBlock(value interface{}) map[string]interface{} {
+	list := value.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	return list[0].(map[string]interface{})
+}
+
+// kubernetes 가 자동으로 붙이는 label / annotation 이다. (사용자가 붙인 app.kubernetes.io/name 등은 유지한다)
+var namespaceSystemKeys = map[string]bool{
+	"kubernetes.io/metadata.name":                      true,
+	"kubectl.kubernetes.io/last-applied-configuration": true,
+}
+
+// kubernetes 가 자동으로 붙이는 label / annotation 은 제외한다.
+func removeSystemKeys(values map[string]string) map[string]string {
+	result := make(map[string]string)
+	for k, v := range values {
+		if namespaceSystemKeys[k] {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+func getNamespaceYaml(name string, labels map[string]interface{}, annotations map[string]interface{}) (string, error) {
+	metadata := map[string]interface{}{
+		"name": name,
+	}
+	if len(labels) != 0 {
+		metadata["labels"] = labels
+	}
+	if len(annotations) != 0 {
+		metadata["annotations"] = annotations
+	}
+
+	return toObjectYaml(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   metadata,
+	})
+}
+
+func getResourceQuotaYaml(namespace string, quota map[string]interface{}) (string, error) {
+	hard := make(map[string]string)
+	setQuantity(hard, "requests.cpu", quota["requests_cpu"])
+	setQuantity(hard, "requests.memory", quota["requests_memory"])
+	setQuantity(hard, "limits.cpu", quota["limits_cpu"])
+	setQuantity(hard, "limits.memory", quota["limits_memory"])
+	setQuantity(hard, "requests.storage", quota["requests_storage"])
+	setQuantity(hard, "pods", quota["pods"])
+	setQuantity(hard, "persistentvolumeclaims", quota["persistent_volume_claims"])
+
+	return toObjectYaml(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ResourceQuota",
+		"metadata": map[string]interface{}{
+			"name":      namespaceResourceQuotaName,
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{
+			"hard": hard,
+		},
+	})
+}
+
+func getLimitRangeYaml(namespace string, limit map[string]interface{}) (string, error) {
+	container := map[string]interface{}{
+		"type": "Container",
+	}
+	for key, prefix := range map[string]string{"default": "default_", "defaultRequest": "default_request_", "max": "max_"} {
+		values := make(map[string]string)
+		setQuantity(values, "cpu", limit[prefix+"cpu"])
+		setQuantity(values, "memory", limit[prefix+"memory"])
+		if len(values) != 0 {
+			container[key] = values
+		}
+	}
+
+	return toObjectYaml(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "LimitRange",
+		"metadata": map[string]interface{}{
+			"name":      namespaceLimitRangeName,
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{
+			"limits": []interface{}{container},
+		},
+	})
+}
+
+// 비어 있거나 0 인 값은 제한 없음으로 보고 넣지 않는다.
+func setQuantity(values map[string]string, key string, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		if len(v) != 0 {
+			values[key] = v
+		}
+	case int:
+		if v != 0 {
+			values[key] = strconv.Itoa(v)
+		}
+	}
+}
+
+func toObjectYaml(object map[string]interface{}) (string, error) {
+	out, err := yaml.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	kubernetesengine2 "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/kubernetes-engine2"
+	"github.com/antihax/optional"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	uuid "github.com/satori/go.uuid"
+)
+
+func init() {
+	samsungcloudplatform.RegisterDataSource("Kubernetes", "samsungcloudplatform_kubernetes_nodes", DatasourceNodes())
+}
+
+func DatasourceNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNodeList,
+		Schema: map[string]*schema.Schema{
+			"kubernetes_engine_id": {Type: schema.TypeString, Required: true, Description: "K8s engine id"},
+			"node_pool_id":         {Type: schema.TypeString, Optional: true, Description: "K8s NodePool id"},
+			"contents":             {Type: schema.TypeList, Computed: true, Description: "K8s node list", Elem: datasourceNodeElem()},
+			"total_count":          {Type: schema.TypeInt, Computed: true, Description: "Total list count"},
+		},
+		Description: "Provides list of K8s nodes",
+	}
+}
+
+func dataSourceNodeList(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	engineId := rd.Get("kubernetes_engine_id").(string)
+	if len(engineId) == 0 {
+		return diag.Errorf("kubernetes engine id not found")
+	}
+
+	responses, _, err := inst.Client.KubernetesEngine.GetNodeList(ctx, engineId, &kubernetesengine2.NodeV2ApiListNodesV2Opts{
+		NodePoolId: optional.NewString(rd.Get("node_pool_id").(string)),
+		Page:       optional.NewInt32(0),
+		Size:       optional.NewInt32(10000),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	contents := make([]map[string]interface{}, 0)
+	for _, node := range responses.Contents {
+		contents = append(contents, map[string]interface{}{
+			"node_name":         node.NodeName,
+			"node_pool_id":      node.NodePoolId,
+			"ip_address":        node.IpAddress,
+			"node_status":       node.NodeStatus,
+			"k8s_version":       node.K8sVersion,
+			"virtual_server_id": node.VirtualServerId,
+			"created_dt":        node.CreatedDt.String(),
+		})
+	}
+
+	rd.SetId(uuid.NewV4().String())
+	rd.Set("contents", contents)
+	rd.Set("total_count", responses.TotalCount)
+
+	return nil
+}
+
+func datasourceNodeElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"node_name":         {Type: schema.TypeString, Computed: true, Description: "Node name"},
+			"node_pool_id":      {Type: schema.TypeString, Computed: true, Description: "NodePool id"},
+			"ip_address":        {Type: schema.TypeString, Computed: true, Description: "Node IP address"},
+			"node_status":       {Type: schema.TypeString, Computed: true, Description: "Node status"},
+			"k8s_version":       {Type: schema.TypeString, Computed: true, Description: "Kubelet version of the node"},
+			"virtual_server_id": {Type: schema.TypeString, Computed: true, Description: "Virtual server id of the node"},
+			"created_dt":        {Type: schema.TypeString, Computed: true, Description: "Creation Date"},
+		},
+	}
+}
@@ -76,6 +76,9 @@ func ResourceKubernetesNodePool() *schema.Resource {
 
 				return nil
 			},
+			customizeNodePoolUpgradeDiff,
+			validateNodePoolSettings,
+			customizeNodePoolRollDiff,
 		),
 
 		Importer: &schema.ResourceImporter{
@@ -145,9 +148,8 @@ func ResourceKubernetesNodePool() *schema.Resource {
 			"scale_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Default:     "s1v2m4",
-				Description: "Scale name",
+				Description: "Scale name (changing it replaces the node pool, or moves workloads to a new node pool with BLUE_GREEN upgrade strategy)",
 			},
 			"storage_name": {
 				Type:        schema.TypeString,
@@ -159,9 +161,8 @@ func ResourceKubernetesNodePool() *schema.Resource {
 			"storage_size_gb": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Default:     "100",
-				Description: "Storage size in GB (default 100)",
+				Description: "Storage size in GB (default 100). Changing it replaces the node pool, or moves workloads to a new node pool with BLUE_GREEN upgrade strategy",
 			},
 			"labels": {
 				Type:     schema.TypeList,
@@ -169,14 +170,16 @@ func ResourceKubernetesNodePool() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"key": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Label Key",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: common.ValidateK8sLabelKey,
+							Description:      "Label Key ([prefix/]name, kubernetes.io and k8s.io prefixes are reserved except node.kubernetes.io and kubelet.kubernetes.io)",
 						},
 						"value": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Label Value",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: common.ValidateK8sLabelValue,
+							Description:      "Label Value",
 						},
 					},
 				},
@@ -188,19 +191,22 @@ func ResourceKubernetesNodePool() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"effect": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Taint Effect",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: common.ValidateK8sTaintEffect,
+							Description:      "Taint Effect (NoSchedule|PreferNoSchedule|NoExecute)",
 						},
 						"key": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Taint Key",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: common.ValidateK8sLabelKey,
+							Description:      "Taint Key ([prefix/]name, kubernetes.io and k8s.io prefixes are reserved except node.kubernetes.io and kubelet.kubernetes.io)",
 						},
 						"value": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Taint Value",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: common.ValidateK8sLabelValue,
+							Description:      "Taint Value",
 						},
 					},
 				},
@@ -213,29 +219,34 @@ func ResourceKubernetesNodePool() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allowed_unsafe_sysctls": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Allowed Unsafe Sysctls",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: common.ValidateSysctlPatterns,
+							Description:      "Allowed Unsafe Sysctls (comma separated sysctl names or patterns ending with *, e.g. kernel.msg*)",
 						},
 						"container_log_max_files": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Container Log Max Files",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Container Log Max Files (at least 2)",
 						},
 						"container_log_max_size": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Container Log Max Size",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Container Log Max Size",
 						},
 						"image_gc_high_threshold": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "ImageGc High Threshold",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+							Description:  "ImageGc High Threshold (percent, greater than image_gc_low_threshold)",
 						},
 						"image_gc_low_threshold": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Image Gc Low Threshold",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+							Description:  "Image Gc Low Threshold (percent)",
 						},
 						"max_pods": {
 							Type:        schema.TypeInt,
@@ -250,6 +261,23 @@ func ResourceKubernetesNodePool() *schema.Resource {
 					},
 				},
 			},
+			"upgrade_settings": upgradeSettingsSchema(),
+			"node_pool_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Actual node pool name (name with a suffix after a blue/green replacement)",
+			},
+			"node_roll_changes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Planned changes that drain and replace or upgrade the nodes of the pool. Only shown in the plan, cleared after apply",
+			},
+			"previous_node_pool_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Node pool replaced by a blue/green upgrade that is not deleted yet",
+			},
 		},
 		Description: "Provides a K8s Node Pool resource.",
 	}
@@ -267,44 +295,8 @@ func createNodePool(ctx context.Context, data *schema.ResourceData, meta interfa
 	inst := meta.(*client.Instance)
 
 	engineId := data.Get("engine_id").(string)
-	scaleName := data.Get("scale_name").(string)
-	var serverType string
-
-	if strings.HasPrefix(scaleName, "h") {
-		serverType = "High Capacity"
-	} else if strings.HasPrefix(scaleName, "g") {
-		serverType = "GPU"
-	} else {
-		serverType = "Standard"
-	}
-
-	if err != nil {
-		return
-	}
 
-	response, _, err := inst.Client.KubernetesEngine.CreateNodePool(
-		ctx,
-		engineId,
-		kubernetesengine.CreateNodePoolRequest{
-			AvailabilityZoneName: data.Get("availability_zone_name").(string),
-			AutoRecovery:         data.Get("auto_recovery").(bool),
-			AutoScale:            data.Get("auto_scale").(bool),
-			ContractName:         "None",
-			DesiredNodeCount:     int32(data.Get("desired_node_count").(int)),
-			EncryptEnabled:       data.Get("encrypt_enabled").(bool),
-			ImageId:              data.Get("image_id").(string),
-			MaxNodeCount:         int32(data.Get("max_node_count").(int)),
-			MinNodeCount:         int32(data.Get("min_node_count").(int)),
-			NodePoolName:         data.Get("name").(string),
-			ServerType:           serverType,
-			ScaleName:            data.Get("scale_name").(string),
-			ServiceLevelName:     "None",
-			StorageName:          data.Get("storage_name").(string),
-			StorageSize:          data.Get("storage_size_gb").(string),
-			Labels:               toLabelRequestList(data.Get("labels").([]interface{})),
-			Taints:               toTaintRequestList(data.Get("taints").([]interface{})),
-			AdvancedSettings:     toAdvancedSettingsRequest(data.Get("advanced_settings").(interface{})),
-		})
+	response, _, err := inst.Client.KubernetesEngine.CreateNodePool(ctx, engineId, getCreateNodePoolRequest(data, data.Get("name").(string)))
 
 	if err != nil {
 		return
@@ -404,7 +396,12 @@ func readNodePool(ctx context.Context, data *schema.ResourceData, meta interface
 	data.Set("image_id", nodePool.ImageId)
 	data.Set("storage_type", storage.ProductName)
 	data.Set("storage_size_gb", nodePool.StorageSize)
-	data.Set("name", nodePool.NodePoolName)
+	if nodePool.NodePoolName != data.Get("name").(string)+blueGreenNodePoolSuffix {
+		data.Set("name", nodePool.NodePoolName)
+	}
+	data.Set("node_pool_name", nodePool.NodePoolName)
+	// node_roll_changes 는 plan 에만 보여주는 값이므로 apply 후에는 비운다.
+	data.Set("node_roll_changes", []string{})
 	data.Set("labels", nodePool.Labels)
 	data.Set("Taints", nodePool.Taints)
 
@@ -414,6 +411,24 @@ func readNodePool(ctx context.Context, data *schema.ResourceData, meta interface
 func updateNodePool(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	inst := meta.(*client.Instance)
 
+	if previousNodePoolId, _ := data.GetChange("previous_node_pool_id"); len(previousNodePoolId.(string)) != 0 {
+		settings := getNodePoolUpgradeSettings(data.Get("upgrade_settings").([]interface{}))
+		err := drainAndDeleteNodePool(ctx, data.Get("engine_id").(string), previousNodePoolId.(string), settings, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// 새 node pool 은 현재 설정 그대로 만들어지므로 나머지 변경 사항은 따로 반영하지 않는다.
+	if isBlueGreenReplace(data) {
+		err := replaceNodePoolBlueGreen(ctx, data, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return readNodePool(ctx, data, meta)
+	}
+
 	if data.HasChanges("labels") {
 		engineId := data.Get("engine_id").(string)
 
@@ -504,7 +519,7 @@ func updateNodePool(ctx context.Context, data *schema.ResourceData, meta interfa
 
 	if data.HasChanges("image_id") {
 		engineId := data.Get("engine_id").(string)
-		nodePool, _, err := inst.Client.KubernetesEngine.ReadNodePool(ctx, data.Get("engine_id").(string), data.Id())
+		nodePool, _, err := inst.Client.KubernetesEngine.ReadNodePool(ctx, engineId, data.Id())
 		beforeImageid := nodePool.ImageId
 		afterImageid := data.Get("image_id").(string)
 
@@ -538,21 +553,10 @@ func updateNodePool(ctx context.Context, data *schema.ResourceData, meta interfa
 
 		// K8s minor version 차이가 1만 나야함
 		if afterK8sMinorVersion-beforeK8sMinorVersion != 1 {
-			return diag.Errorf("Cannot upgrade from " + beforeK8sVersion + " to " + afterK8sVersion)
+			return diag.Errorf("Cannot upgrade from " + beforeK8sVersion + " to " + afterK8sVersion + " (use upgrade_policy of the kubernetes engine to upgrade node pools through several minor versions)")
 		}
 
-		_, _, err = inst.Client.KubernetesEngine.UpgradeNodePool(ctx, engineId, data.Id(), kubernetesengine.NodePoolUgradeRequest{
-			UpgradeImageId: data.Get("image_id").(string),
-		})
-
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		time.Sleep(5 * time.Second)
-
-		//FAIL, ERROR, NOT READY, RUNNING
-		err = client.WaitForStatus(ctx, inst.Client, []string{}, []string{"Running"}, refreshNodePool(ctx, meta, engineId, data.Id(), true))
-
+		err = upgradeNodePoolImage(ctx, data, meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -573,6 +577,13 @@ func deleteNodePool(ctx context.Context, data *schema.ResourceData, meta interfa
 	inst := meta.(*client.Instance)
 	engineId := data.Get("engine_id").(string)
 
+	if previousNodePoolId := data.Get("previous_node_pool_id").(string); len(previousNodePoolId) != 0 {
+		err = drainAndDeleteNodePool(ctx, engineId, previousNodePoolId, getNodePoolUpgradeSettings(data.Get("upgrade_settings").([]interface{})), meta)
+		if err != nil {
+			return
+		}
+	}
+
 	_, err = inst.Client.KubernetesEngine.DeleteNodePool(ctx, engineId, data.Id())
 	if err != nil && !common.IsDeleted(err) {
 		return
@@ -625,6 +636,40 @@ func refreshNodePool(ctx context.Context, meta interface{}, engineId string, nod
 	}
 }
 
+func getCreateNodePoolRequest(data *schema.ResourceData, nodePoolName string) kubernetesengine.CreateNodePoolRequest {
+	scaleName := data.Get("scale_name").(string)
+	var serverType string
+
+	if strings.HasPrefix(scaleName, "h") {
+		serverType = "High Capacity"
+	} else if strings.HasPrefix(scaleName, "g") {
+		serverType = "GPU"
+	} else {
+		serverType = "Standard"
+	}
+
+	return kubernetesengine.CreateNodePoolRequest{
+		AvailabilityZoneName: data.Get("availability_zone_name").(string),
+		AutoRecovery:         data.Get("auto_recovery").(bool),
+		AutoScale:            data.Get("auto_scale").(bool),
+		ContractName:         "None",
+		DesiredNodeCount:     int32(data.Get("desired_node_count").(int)),
+		EncryptEnabled:       data.Get("encrypt_enabled").(bool),
+		ImageId:              data.Get("image_id").(string),
+		MaxNodeCount:         int32(data.Get("max_node_count").(int)),
+		MinNodeCount:         int32(data.Get("min_node_count").(int)),
+		NodePoolName:         nodePoolName,
+		ServerType:           serverType,
+		ScaleName:            scaleName,
+		ServiceLevelName:     "None",
+		StorageName:          data.Get("storage_name").(string),
+		StorageSize:          data.Get("storage_size_gb").(string),
+		Labels:               toLabelRequestList(data.Get("labels").([]interface{})),
+		Taints:               toTaintRequestList(data.Get("taints").([]interface{})),
+		AdvancedSettings:     toAdvancedSettingsRequest(data.Get("advanced_settings").(interface{})),
+	}
+}
+
 func getImageInfo(ctx context.Context, imageId string, meta interface{}) (string, string, string, string, string, error) {
 	var err error = nil
 	inst := meta.(*client.Instance)
@@ -0,0 +1,251 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/kubernetesengine"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	NodePoolUpgradeStrategySurge     = "SURGE"
+	NodePoolUpgradeStrategyBlueGreen = "BLUE_GREEN"
+
+	blueGreenNodePoolSuffix = "-g"
+)
+
+// BLUE_GREEN 전략에서는 아래 항목이 바뀌면 node pool 을 새로 만들어 workload 를 옮긴 후 기존 node pool 을 삭제한다.
+var blueGreenReplaceKeys = []string{"image_id", "scale_name", "storage_size_gb"}
+
+func upgradeSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"strategy": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          NodePoolUpgradeStrategySurge,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{NodePoolUpgradeStrategySurge, NodePoolUpgradeStrategyBlueGreen}, false)),
+					Description:      "Upgrade strategy (SURGE|BLUE_GREEN). BLUE_GREEN creates a new node pool, drains the old one and deletes it when image_id, scale_name or storage_size_gb changes",
+				},
+				"max_surge": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 10),
+					Description:  "Number of nodes temporarily added while the node pool (without auto scale) is upgraded",
+				},
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 10),
+					Description:  "Maximum number of nodes upgraded at the same time. 0 uses the platform default",
+				},
+				"drain_timeout_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 1440),
+					Description:  "Maximum time in minutes to wait for a node to drain. 0 uses the platform default",
+				},
+				"respect_pdb": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Respect PodDisruptionBudgets while draining nodes",
+				},
+			},
+		},
+		Description: "Node pool upgrade settings. Node pool upgrades run by upgrade_policy of the kubernetes engine use the settings of that policy instead",
+	}
+}
+
+type nodePoolUpgradeSettings struct {
+	strategy            string
+	maxSurge            int
+	maxUnavailable      int
+	drainTimeoutMinutes int
+	respectPdb          bool
+}
+
+func getNodePoolUpgradeSettings(settings []interface{}) nodePoolUpgradeSettings {
+	result := nodePoolUpgradeSettings{
+		strategy:   NodePoolUpgradeStrategySurge,
+		respectPdb: true,
+	}
+	if len(settings) == 0 || settings[0] == nil {
+		return result
+	}
+
+	m := settings[0].(map[string]interface{})
+	result.strategy = m["strategy"].(string)
+	result.maxSurge = m["max_surge"].(int)
+	result.maxUnavailable = m["max_unavailable"].(int)
+	result.drainTimeoutMinutes = m["drain_timeout_minutes"].(int)
+	result.respectPdb = m["respect_pdb"].(bool)
+	return result
+}
+
+// SURGE 전략에서는 scale_name / storage_size_gb 변경 시 node pool 을 교체하고,
+// BLUE_GREEN 전략에서는 update 단계에서 새 node pool 로 옮긴다.
+func customizeNodePoolUpgradeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	settings := getNodePoolUpgradeSettings(diff.Get("upgrade_settings").([]interface{}))
+
+	if settings.strategy == NodePoolUpgradeStrategyBlueGreen {
+		if len(diff.Get("name").(string)) > 20-len(blueGreenNodePoolSuffix) {
+			return fmt.Errorf("name must be at most %d characters when the upgrade strategy is %s", 20-len(blueGreenNodePoolSuffix), NodePoolUpgradeStrategyBlueGreen)
+		}
+	} else if len(diff.Id()) != 0 {
+		for _, key := range []string{"scale_name", "storage_size_gb"} {
+			if diff.HasChange(key) {
+				if err := diff.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// 이전 blue/green 교체에서 삭제하지 못한 node pool 이 남아 있으면 다음 apply 에서 다시 정리한다.
+	if len(diff.Get("previous_node_pool_id").(string)) != 0 {
+		return diff.SetNew("previous_node_pool_id", "")
+	}
+
+	return nil
+}
+
+func isBlueGreenReplace(data *schema.ResourceData) bool {
+	settings := getNodePoolUpgradeSettings(data.Get("upgrade_settings").([]interface{}))
+	return settings.strategy == NodePoolUpgradeStrategyBlueGreen && data.HasChanges(blueGreenReplaceKeys...)
+}
+
+// 새 node pool 을 만들고 Running 이 되면 관리 대상을 새 node pool 로 바꾼 후,
+// 기존 node pool 을 drain 하고 삭제한다. drain / 삭제에 실패하면 previous_node_pool_id 에 남겨 다음 apply 에서 다시 시도한다.
+func replaceNodePoolBlueGreen(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+	engineId := data.Get("engine_id").(string)
+	oldNodePoolId := data.Id()
+
+	oldNodePool, _, err := inst.Client.KubernetesEngine.ReadNodePool(ctx, engineId, oldNodePoolId)
+	if err != nil {
+		return err
+	}
+
+	name := data.Get("name").(string)
+	newName := name + blueGreenNodePoolSuffix
+	if oldNodePool.NodePoolName == newName {
+		newName = name
+	}
+
+	log.Printf("[INFO] Kubernetes node pool blue/green replace : %s -> %s", oldNodePool.NodePoolName, newName)
+
+	response, _, err := inst.Client.KubernetesEngine.CreateNodePool(ctx, engineId, getCreateNodePoolRequest(data, newName))
+	if err != nil {
+		return err
+	}
+
+	time.Sleep(5 * time.Second)
+
+	err = client.WaitForStatus(ctx, inst.Client, []string{}, []string{"Running"}, refreshNodePool(ctx, meta, engineId, response.ResourceId, true))
+	if err != nil {
+		// 새 node pool 이 준비되지 않았으면 기존 node pool 을 그대로 두고 새 node pool 만 정리한다.
+		if _, deleteErr := inst.Client.KubernetesEngine.DeleteNodePool(ctx, engineId, response.ResourceId); deleteErr != nil && !common.IsDeleted(deleteErr) {
+			log.Printf("[WARN] Failed to delete kubernetes node pool %s : %s", response.ResourceId, deleteErr)
+		}
+		return fmt.Errorf("new node pool %s is not ready : %s", newName, err)
+	}
+
+	data.SetId(response.ResourceId)
+	data.Set("previous_node_pool_id", oldNodePoolId)
+
+	err = drainAndDeleteNodePool(ctx, engineId, oldNodePoolId, getNodePoolUpgradeSettings(data.Get("upgrade_settings").([]interface{})), meta)
+	if err != nil {
+		return fmt.Errorf("workloads moved to node pool %s but the previous node pool %s is not deleted (retried on next apply) : %s", newName, oldNodePool.NodePoolName, err)
+	}
+	data.Set("previous_node_pool_id", "")
+
+	return nil
+}
+
+func drainAndDeleteNodePool(ctx context.Context, engineId string, nodePoolId string, settings nodePoolUpgradeSettings, meta interface{}) error {
+	inst := meta.(*client.Instance)
+
+	_, _, err := inst.Client.KubernetesEngine.DrainNodePool(ctx, engineId, nodePoolId, kubernetesengine.NodePoolDrainRequest{
+		DrainTimeoutMinutes: int32(settings.drainTimeoutMinutes),
+		RespectPdb:          settings.respectPdb,
+	})
+	if err != nil {
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return err
+	}
+
+	time.Sleep(5 * time.Second)
+
+	err = client.WaitForStatus(ctx, inst.Client, []string{}, []string{"Running"}, refreshNodePool(ctx, meta, engineId, nodePoolId, true))
+	if err != nil {
+		return err
+	}
+
+	_, err = inst.Client.KubernetesEngine.DeleteNodePool(ctx, engineId, nodePoolId)
+	if err != nil && !common.IsDeleted(err) {
+		return err
+	}
+
+	time.Sleep(5 * time.Second)
+
+	return client.WaitForStatus(ctx, inst.Client, []string{"Deleting"}, []string{"DELETED"}, refreshNodePool(ctx, meta, engineId, nodePoolId, false))
+}
+
+// node pool image 를 in-place 로 올린다. auto scale 이 아닌 node pool 은 upgrade 동안 노드를 max_surge 만큼 늘려둔다.
+func upgradeNodePoolImage(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
+	inst := meta.(*client.Instance)
+	engineId := data.Get("engine_id").(string)
+	settings := getNodePoolUpgradeSettings(data.Get("upgrade_settings").([]interface{}))
+
+	nodePool, _, err := inst.Client.KubernetesEngine.ReadNodePool(ctx, engineId, data.Id())
+	if err != nil {
+		return err
+	}
+
+	surge := settings.maxSurge > 0 && !*nodePool.AutoScale
+	if surge {
+		err = resizeNodePool(ctx, engineId, data.Id(), nodePool, nodePool.DesiredNodeCount+int32(settings.maxSurge), meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, _, err = inst.Client.KubernetesEngine.UpgradeNodePool(ctx, engineId, data.Id(), kubernetesengine.NodePoolUgradeRequest{
+		UpgradeImageId:      data.Get("image_id").(string),
+		DrainTimeoutMinutes: int32(settings.drainTimeoutMinutes),
+		MaxUnavailable:      int32(settings.maxUnavailable),
+		RespectPdb:          settings.respectPdb,
+	})
+	if err != nil {
+		return err
+	}
+	time.Sleep(5 * time.Second)
+
+	//FAIL, ERROR, NOT READY, RUNNING
+	err = client.WaitForStatus(ctx, inst.Client, []string{}, []string{"Running"}, refreshNodePool(ctx, meta, engineId, data.Id(), true))
+	if err != nil {
+		return err
+	}
+
+	if surge {
+		return resizeNodePool(ctx, engineId, data.Id(), nodePool, nodePool.DesiredNodeCount, meta)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// labels / taints / advanced_settings 를 API 호출 전에 plan 단계에서 검증한다.
+func validateNodePoolSettings(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	labelKeys := make(map[string]bool)
+	for _, v := range diff.Get("labels").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		key := v.(common.HclKeyValueObject)["key"].(string)
+		if labelKeys[key] {
+			return fmt.Errorf("label %q is declared more than once", key)
+		}
+		labelKeys[key] = true
+	}
+
+	taintKeys := make(map[string]bool)
+	for _, v := range diff.Get("taints").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		taint := v.(common.HclKeyValueObject)
+		key := taint["key"].(string) + ":" + taint["effect"].(string)
+		if taintKeys[key] {
+			return fmt.Errorf("taint %q is declared more than once", key)
+		}
+		taintKeys[key] = true
+	}
+
+	for _, v := range diff.Get("advanced_settings").(*schema.Set).List() {
+		settings := v.(map[string]interface{})
+
+		high := settings["image_gc_high_threshold"].(int)
+		low := settings["image_gc_low_threshold"].(int)
+		if high != 0 && low != 0 && high <= low {
+			return fmt.Errorf("image_gc_high_threshold (%d) must be greater than image_gc_low_threshold (%d)", high, low)
+		}
+
+		if maxFiles := settings["container_log_max_files"].(int); maxFiles == 1 {
+			return fmt.Errorf("container_log_max_files must be at least 2")
+		}
+	}
+
+	return nil
+}
+
+// 노드를 다시 만들거나 순차적으로 교체하는 변경 사항을 node_roll_changes 에 기록해 plan 에 보이도록 한다.
+func customizeNodePoolRollDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if len(diff.Id()) == 0 {
+		return nil
+	}
+
+	settings := getNodePoolUpgradeSettings(diff.Get("upgrade_settings").([]interface{}))
+
+	changes := make([]string, 0)
+	for _, key := range blueGreenReplaceKeys {
+		if !diff.HasChange(key) {
+			continue
+		}
+
+		if settings.strategy == NodePoolUpgradeStrategyBlueGreen {
+			changes = append(changes, fmt.Sprintf("%s : workloads move to a new node pool and the current nodes are drained and deleted", key))
+		} else if key == "image_id" {
+			changes = append(changes, fmt.Sprintf("%s : nodes are drained and upgraded in place", key))
+		} else {
+			changes = append(changes, fmt.Sprintf("%s : the node pool and all of its nodes are replaced", key))
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	for _, change := range changes {
+		log.Printf("[WARN] Kubernetes node pool(%s) nodes will roll : %s", diff.Id(), change)
+	}
+
+	return diff.SetNew("node_roll_changes", changes)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func init() {
@@ -52,11 +53,28 @@ func ResourcePlacementGroup() *schema.Resource {
 			},
 			"virtual_server_ids": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Description: "Virtual Server Id List",
+				Description: "Virtual Server Id List. Leave unset when membership is managed with samsungcloudplatform_placement_group_membership.",
+			},
+			"placement_strategy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"SPREAD", "PARTITION"}, false)),
+				Description:      "Placement strategy (default SPREAD). SPREAD places each virtual server on a different host (anti-affinity), PARTITION spreads groups of virtual servers over partitions that do not share hosts. (SPREAD, PARTITION)",
+			},
+			"partition_count": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(2, 7)),
+				Description:      "Number of partitions. Only valid for PARTITION strategy. (2 to 7)",
 			},
 			"availability_zone_name": {
 				Type:        schema.TypeString,
@@ -70,6 +88,12 @@ func ResourcePlacementGroup() *schema.Resource {
 			},
 			"tags": tfTags.TagsSchema(),
 		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			if diff.Get("placement_strategy").(string) != "PARTITION" && !diff.GetRawConfig().GetAttr("partition_count").IsNull() {
+				return fmt.Errorf("partition_count can only be set with PARTITION placement strategy")
+			}
+			return nil
+		},
 	}
 }
 
@@ -102,6 +126,18 @@ func resourcePlacementGroupCreate(ctx context.Context, rd *schema.ResourceData,
 		})
 	}
 
+	placementStrategy := rd.Get("placement_strategy").(string)
+	if len(placementStrategy) == 0 {
+		placementStrategy = "SPREAD"
+	}
+	var partitionCount int32
+	if placementStrategy == "PARTITION" {
+		partitionCount = int32(rd.Get("partition_count").(int))
+		if partitionCount == 0 {
+			partitionCount = 2
+		}
+	}
+
 	createResponse, err := inst.Client.PlacementGroup.CreatePlacementGroup(ctx, placementgroup.CreateRequest{
 		AvailabilityZoneName:      availabilityZoneName,
 		PlacementGroupName:        placementGroupName,
@@ -109,6 +145,8 @@ func resourcePlacementGroupCreate(ctx context.Context, rd *schema.ResourceData,
 		Tags:                      rd.Get("tags").(map[string]interface{}),
 		VirtualServerType:         virtualServerType,
 		PlacementGroupDescription: description,
+		PlacementStrategy:         placementStrategy,
+		PartitionCount:            partitionCount,
 	})
 	if err != nil {
 		return
@@ -147,6 +185,13 @@ func resourcePlacementGroupRead(ctx context.Context, rd *schema.ResourceData, me
 	rd.Set("virtual_server_type", placementGroupInfo.VirtualServerType)
 	rd.Set("service_zone_id", placementGroupInfo.ServiceZoneId)
 	rd.Set("virtual_server_ids", placementGroupInfo.VirtualServerIdList)
+	// 전략이 없는 기존 placement group 은 SPREAD 로 동작한다.
+	if len(placementGroupInfo.PlacementStrategy) != 0 {
+		rd.Set("placement_strategy", placementGroupInfo.PlacementStrategy)
+	} else {
+		rd.Set("placement_strategy", "SPREAD")
+	}
+	rd.Set("partition_count", placementGroupInfo.PartitionCount)
 	tfTags.SetTags(ctx, rd, meta, rd.Id())
 
 	return nil
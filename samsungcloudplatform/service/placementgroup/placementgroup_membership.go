@@ -0,0 +1,119 @@
+package placementgroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/virtualserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func init() {
+	samsungcloudplatform.RegisterResource("Placement Group", "samsungcloudplatform_placement_group_membership", ResourcePlacementGroupMembership())
+}
+
+func ResourcePlacementGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePlacementGroupMembershipCreate,
+		ReadContext:   resourcePlacementGroupMembershipRead,
+		DeleteContext: resourcePlacementGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"placement_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Placement Group Id",
+			},
+			"virtual_server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Virtual Server Id to add to the placement group",
+			},
+		},
+		Description: "Provides a Placement Group membership resource. The ID of this resource is the virtual server id.",
+	}
+}
+
+func resourcePlacementGroupMembershipCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	placementGroupId := rd.Get("placement_group_id").(string)
+	virtualServerId := rd.Get("virtual_server_id").(string)
+
+	err := WaitForPlacementGroupState(ctx, inst.Client, placementGroupId, []string{}, []string{common.ActiveState}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = virtualserver.WaitForVirtualServerStatus(ctx, inst.Client, virtualServerId, common.VirtualServerProcessingStates(), []string{common.RunningState, common.StoppedState}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = inst.Client.PlacementGroup.AddPlacementGroupMember(ctx, placementGroupId, virtualServerId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = virtualserver.WaitForVirtualServerStatus(ctx, inst.Client, virtualServerId, common.VirtualServerProcessingStates(), []string{common.RunningState, common.StoppedState}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(virtualServerId)
+
+	return resourcePlacementGroupMembershipRead(ctx, rd, meta)
+}
+
+func resourcePlacementGroupMembershipRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	virtualServerInfo, _, err := inst.Client.VirtualServer.GetVirtualServer(ctx, rd.Id())
+	if err != nil {
+		rd.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// placement group 에서 제외된 경우 재생성
+	if len(virtualServerInfo.PlacementGroupId) == 0 {
+		rd.SetId("")
+		return nil
+	}
+
+	rd.Set("placement_group_id", virtualServerInfo.PlacementGroupId)
+	rd.Set("virtual_server_id", virtualServerInfo.VirtualServerId)
+
+	return nil
+}
+
+func resourcePlacementGroupMembershipDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	placementGroupId := rd.Get("placement_group_id").(string)
+
+	err := inst.Client.PlacementGroup.RemovePlacementGroupMember(ctx, placementGroupId, rd.Id())
+	if err != nil {
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to remove virtual server %s from placement group %s : %s", rd.Id(), placementGroupId, err))
+	}
+
+	err = virtualserver.WaitForVirtualServerStatus(ctx, inst.Client, rd.Id(), common.VirtualServerProcessingStates(), []string{common.RunningState, common.StoppedState}, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
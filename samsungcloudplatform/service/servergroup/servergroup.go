@@ -0,0 +1,127 @@
+package servergroup
+
+import (
+	"context"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/servergroup"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func init() {
+	samsungcloudplatform.RegisterResource("Server Group", "samsungcloudplatform_server_group", ResourceServerGroup())
+}
+
+func ResourceServerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServerGroupCreate,
+		ReadContext:   resourceServerGroupRead,
+		DeleteContext: resourceServerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"server_group_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: common.ValidateName3to20DashInMiddle,
+				Description:      "Server Group Name",
+			},
+			"service_zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Service Zone Id",
+			},
+			"affinity_policy_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "ANTI_AFFINITY",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ANTI_AFFINITY", "AFFINITY"}, false)),
+				Description:      "Affinity policy of the servers in the group (ANTI_AFFINITY, AFFINITY)",
+			},
+			"serviced_for": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     common.ServicedForVirtualServer,
+				Description: "Service that uses the server group (e.g. Virtual Server)",
+			},
+			"serviced_group_for": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     common.ServicedGroupCompute,
+				Description: "Service group that uses the server group (e.g. COMPUTE)",
+			},
+			"server_group_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Server Group Type",
+			},
+			"created_by": {Type: schema.TypeString, Computed: true, Description: "The person who created the resource"},
+			"created_dt": {Type: schema.TypeString, Computed: true, Description: "Creation time"},
+		},
+		Description: "Provides a Server Group resource to place virtual servers with an affinity policy.",
+	}
+}
+
+func resourceServerGroupCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	response, err := inst.Client.ServerGroup.CreateServerGroup(ctx, servergroup.CreateServerGroupRequest{
+		AffinityPolicyType: rd.Get("affinity_policy_type").(string),
+		ServerGroupName:    rd.Get("server_group_name").(string),
+		ServiceZoneId:      rd.Get("service_zone_id").(string),
+		ServicedFor:        rd.Get("serviced_for").(string),
+		ServicedGroupFor:   rd.Get("serviced_group_for").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(response.ServerGroupId)
+
+	return resourceServerGroupRead(ctx, rd, meta)
+}
+
+func resourceServerGroupRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	info, _, err := inst.Client.ServerGroup.GetServerGroupDetail(ctx, rd.Id())
+	if err != nil {
+		rd.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	rd.Set("server_group_name", info.ServerGroupName)
+	rd.Set("service_zone_id", info.ServiceZoneId)
+	rd.Set("affinity_policy_type", info.AffinityPolicyType)
+	rd.Set("serviced_for", info.ServicedFor)
+	rd.Set("serviced_group_for", info.ServicedGroupFor)
+	rd.Set("server_group_type", info.ServerGroupType)
+	rd.Set("created_by", info.CreatedBy)
+	rd.Set("created_dt", info.CreatedDt.String())
+
+	return nil
+}
+
+func resourceServerGroupDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	_, err := inst.Client.ServerGroup.DeleteServerGroup(ctx, rd.Id())
+	if err != nil && !common.IsDeleted(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
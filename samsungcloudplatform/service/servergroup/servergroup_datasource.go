@@ -0,0 +1,84 @@
+package servergroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	servergroup2 "github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/server-group2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func init() {
+	samsungcloudplatform.RegisterDataSource("Server Group", "samsungcloudplatform_server_group", DatasourceServerGroup())
+}
+
+func DatasourceServerGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServerGroupRead,
+		Schema: map[string]*schema.Schema{
+			"server_group_id":      {Type: schema.TypeString, Optional: true, Computed: true, Description: "Server Group Id"},
+			"server_group_name":    {Type: schema.TypeString, Optional: true, Computed: true, Description: "Server Group Name"},
+			"serviced_for":         {Type: schema.TypeString, Optional: true, Default: common.ServicedForVirtualServer, Description: "Service that uses the server group (e.g. Virtual Server)"},
+			"affinity_policy_type": {Type: schema.TypeString, Optional: true, Computed: true, Description: "Affinity policy of the servers in the group (ANTI_AFFINITY, AFFINITY)"},
+			"service_zone_id":      {Type: schema.TypeString, Optional: true, Computed: true, Description: "Service Zone Id"},
+			"serviced_group_for":   {Type: schema.TypeString, Computed: true, Description: "Service group that uses the server group"},
+			"server_group_type":    {Type: schema.TypeString, Computed: true, Description: "Server Group Type"},
+			"created_by":           {Type: schema.TypeString, Computed: true, Description: "The person who created the resource"},
+			"created_dt":           {Type: schema.TypeString, Computed: true, Description: "Creation time"},
+		},
+		Description: "Provides information of a Server Group.",
+	}
+}
+
+func dataSourceServerGroupRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	responses, err := inst.Client.ServerGroup.GetServerGroupByServicedForCondition(ctx, rd.Get("server_group_name").(string), rd.Get("serviced_for").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverGroupId := rd.Get("server_group_id").(string)
+	affinityPolicyType := rd.Get("affinity_policy_type").(string)
+	serviceZoneId := rd.Get("service_zone_id").(string)
+
+	matched := make([]servergroup2.ServerGroupsResponse, 0)
+	for _, sg := range responses.Contents {
+		if len(serverGroupId) > 0 && sg.ServerGroupId != serverGroupId {
+			continue
+		}
+		if len(affinityPolicyType) > 0 && sg.AffinityPolicyType != affinityPolicyType {
+			continue
+		}
+		if len(serviceZoneId) > 0 && sg.ServiceZoneId != serviceZoneId {
+			continue
+		}
+		matched = append(matched, sg)
+	}
+
+	if len(matched) == 0 {
+		return diag.Errorf("no matching server group found")
+	}
+	if len(matched) > 1 {
+		return diag.FromErr(fmt.Errorf("%d server groups matched. Use more specific arguments", len(matched)))
+	}
+
+	info := matched[0]
+
+	rd.SetId(info.ServerGroupId)
+	rd.Set("server_group_id", info.ServerGroupId)
+	rd.Set("server_group_name", info.ServerGroupName)
+	rd.Set("serviced_for", info.ServicedFor)
+	rd.Set("affinity_policy_type", info.AffinityPolicyType)
+	rd.Set("service_zone_id", info.ServiceZoneId)
+	rd.Set("serviced_group_for", info.ServicedGroupFor)
+	rd.Set("server_group_type", info.ServerGroupType)
+	rd.Set("created_by", info.CreatedBy)
+	rd.Set("created_dt", info.CreatedDt.String())
+
+	return nil
+}
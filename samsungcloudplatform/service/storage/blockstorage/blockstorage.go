@@ -97,6 +97,12 @@ func ResourceBlockStorage() *schema.Resource {
 				ForceNew:    true,
 				Description: "The block storage whether to use encryption. This can be enabled when the virtual server is encryption enabled.",
 			},
+			"snapshot_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Snapshot ID from which the block storage is created. The storage size must be equal to or greater than the source volume.",
+			},
 			"tags": tfTags.TagsSchema(),
 		},
 		Description: "Provides a Block Storage resource.",
@@ -140,6 +146,7 @@ func createBlockStorage(ctx context.Context, data *schema.ResourceData, meta int
 		DiskType:         data.Get("product_name").(string),
 		SharedType:       sharedType,
 		VirtualServerId:  finalVirtualServerId,
+		SnapshotId:       data.Get("snapshot_id").(string),
 	}, data.Get("tags").(map[string]interface{}))
 
 	if err != nil {
@@ -0,0 +1,169 @@
+package blockstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/storage/blockstorage"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func init() {
+	samsungcloudplatform.RegisterResource("Block Storage(VM)", "samsungcloudplatform_block_storage_snapshot", ResourceBlockStorageSnapshot())
+}
+
+func ResourceBlockStorageSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createBlockStorageSnapshot,
+		ReadContext:   readBlockStorageSnapshot,
+		DeleteContext: deleteBlockStorageSnapshot,
+		Importer: &schema.ResourceImporter{
+			StateContext: importBlockStorageSnapshot,
+		},
+		Schema: map[string]*schema.Schema{
+			"block_storage_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Block storage ID to take the snapshot from.",
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The snapshot name to create. (3 to 28 characters with -)",
+				ValidateDiagFunc: common.ValidateName3to28Dash,
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "Snapshot description. (Up to 50 characters)",
+				ValidateDiagFunc: common.ValidateDescriptionMaxlength50,
+			},
+			"snapshot_size_gb": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size(GB) of the source block storage at the time of the snapshot.",
+			},
+			"snapshot_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Snapshot status",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The person who created the resource",
+			},
+			"created_dt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date",
+			},
+		},
+		Description: "Provides a Block Storage snapshot resource.",
+	}
+}
+
+func createBlockStorageSnapshot(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	blockStorageId := data.Get("block_storage_id").(string)
+
+	err := waitForBlockStorageStatus(ctx, inst.Client, blockStorageId, []string{}, []string{common.ActiveState}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := inst.Client.BlockStorage.CreateBlockStorageSnapshot(ctx, blockStorageId, blockstorage.CreateBlockStorageSnapshotRequest{
+		SnapshotName:        data.Get("name").(string),
+		SnapshotDescription: data.Get("description").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = waitForBlockStorageSnapshotStatus(ctx, inst.Client, blockStorageId, response.ResourceId, []string{}, []string{common.ActiveState}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(response.ResourceId)
+
+	return readBlockStorageSnapshot(ctx, data, meta)
+}
+
+func readBlockStorageSnapshot(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	info, _, err := inst.Client.BlockStorage.ReadBlockStorageSnapshot(ctx, data.Get("block_storage_id").(string), data.Id())
+	if err != nil {
+		data.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	data.Set("name", info.SnapshotName)
+	data.Set("description", info.SnapshotDescription)
+	data.Set("snapshot_size_gb", info.SnapshotSize)
+	data.Set("snapshot_state", info.SnapshotState)
+	data.Set("created_by", info.CreatedBy)
+	data.Set("created_dt", info.CreatedDt.String())
+
+	return nil
+}
+
+// import ID 형식 : {block_storage_id}/{snapshot_id}
+func importBlockStorageSnapshot(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(data.Id(), "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return nil, fmt.Errorf("invalid import ID %q : expected {block_storage_id}/{snapshot_id}", data.Id())
+	}
+
+	data.Set("block_storage_id", parts[0])
+	data.SetId(parts[1])
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func deleteBlockStorageSnapshot(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	blockStorageId := data.Get("block_storage_id").(string)
+
+	_, err := inst.Client.BlockStorage.DeleteBlockStorageSnapshot(ctx, blockStorageId, data.Id())
+	if err != nil && !common.IsDeleted(err) {
+		return diag.FromErr(err)
+	}
+
+	err = waitForBlockStorageSnapshotStatus(ctx, inst.Client, blockStorageId, data.Id(), []string{}, []string{common.DeletedState}, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func waitForBlockStorageSnapshotStatus(ctx context.Context, scpClient *client.SCPClient, blockStorageId string, id string, pendingStates []string, targetStates []string, errorOnNotFound bool) error {
+	return client.WaitForStatus(ctx, scpClient, pendingStates, targetStates, func() (interface{}, string, error) {
+		info, c, err := scpClient.BlockStorage.ReadBlockStorageSnapshot(ctx, blockStorageId, id)
+		if err != nil {
+			if (c == 404 || c == 403) && !errorOnNotFound {
+				return "", common.DeletedState, nil
+			}
+			return nil, "", err
+		}
+		if info.SnapshotId != id {
+			return nil, "", fmt.Errorf("invalid resource status")
+		}
+		return info, info.SnapshotState, nil
+	})
+}
@@ -0,0 +1,71 @@
+package blockstorage
+
+import (
+	"context"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/storage/blockstorage"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	uuid "github.com/satori/go.uuid"
+)
+
+func init() {
+	samsungcloudplatform.RegisterDataSource("Block Storage(VM)", "samsungcloudplatform_block_storage_snapshots", DatasourceBlockStorageSnapshots())
+}
+
+func DatasourceBlockStorageSnapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSnapshotList,
+		Schema: map[string]*schema.Schema{
+			"block_storage_id": {Type: schema.TypeString, Required: true, Description: "Block storage id"},
+			"snapshot_name":    {Type: schema.TypeString, Optional: true, Description: "Snapshot name"},
+			"page":             {Type: schema.TypeInt, Optional: true, Default: 0, Description: "Page start number from which to get the list"},
+			"size":             {Type: schema.TypeInt, Optional: true, Default: 20, Description: "Size to get list"},
+			"contents":         {Type: schema.TypeList, Optional: true, Description: "Block storage snapshot list", Elem: datasourceSnapshotElem()},
+			"total_count":      {Type: schema.TypeInt, Computed: true, Description: "Total list size"},
+		},
+		Description: "Provides list of block storage snapshots",
+	}
+}
+
+func dataSourceSnapshotList(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	responses, err := inst.Client.BlockStorage.ReadBlockStorageSnapshotList(ctx, rd.Get("block_storage_id").(string), blockstorage.ReadBlockStorageSnapshotRequest{
+		SnapshotName: rd.Get("snapshot_name").(string),
+		Page:         (int32)(rd.Get("page").(int)),
+		Size:         (int32)(rd.Get("size").(int)),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	contents := common.ConvertStructToMaps(responses.Contents)
+
+	rd.SetId(uuid.NewV4().String())
+	rd.Set("contents", contents)
+	rd.Set("total_count", responses.TotalCount)
+
+	return nil
+}
+
+func datasourceSnapshotElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project_id":           {Type: schema.TypeString, Computed: true, Description: "Project id"},
+			"block_storage_id":     {Type: schema.TypeString, Computed: true, Description: "Source block storage id"},
+			"snapshot_id":          {Type: schema.TypeString, Computed: true, Description: "Snapshot id"},
+			"snapshot_name":        {Type: schema.TypeString, Computed: true, Description: "Snapshot name"},
+			"snapshot_description": {Type: schema.TypeString, Computed: true, Description: "Snapshot description"},
+			"snapshot_size":        {Type: schema.TypeInt, Computed: true, Description: "Snapshot size(GB)"},
+			"snapshot_state":       {Type: schema.TypeString, Computed: true, Description: "Snapshot status"},
+			"created_by":           {Type: schema.TypeString, Computed: true, Description: "Person who created the resource"},
+			"created_dt":           {Type: schema.TypeString, Computed: true, Description: "Creation time"},
+			"modified_by":          {Type: schema.TypeString, Computed: true, Description: "Person who modified the resource"},
+			"modified_dt":          {Type: schema.TypeString, Computed: true, Description: "Modification time"},
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package bmblockstorage
+
+import (
+	"context"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	uuid "github.com/satori/go.uuid"
+)
+
+func init() {
+	samsungcloudplatform.RegisterDataSource("Block Storage(BM)", "samsungcloudplatform_bm_block_storage_snapshots", DatasourceBmBlockStorageSnapshots())
+}
+
+func DatasourceBmBlockStorageSnapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: bmBlockStorageSnapshotList,
+		Schema: map[string]*schema.Schema{
+			common.ToSnakeCase("BmBlockStorageId"):     {Type: schema.TypeString, Required: true, Description: "Baremetal Block Storage Id"},
+			common.ToSnakeCase("IsSnapshotPolicy"):     {Type: schema.TypeBool, Computed: true, Description: "Snapshot policy enabled"},
+			common.ToSnakeCase("SnapshotCapacityRate"): {Type: schema.TypeInt, Computed: true, Description: "Snapshot capacity rate"},
+			"contents":    {Type: schema.TypeList, Optional: true, Description: "Baremetal Block Storage Snapshots", Elem: bmBlockStorageSnapshotsElem()},
+			"total_count": {Type: schema.TypeInt, Computed: true, Description: "Total list size"},
+		},
+		Description: "Provides Block Storage(BM) Snapshot List",
+	}
+}
+
+func bmBlockStorageSnapshotList(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	responses, _, err := inst.Client.BareMetalBlockStorage.GetBareMetalBlockStorageSnapshotList(ctx, rd.Get(common.ToSnakeCase("BmBlockStorageId")).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	contents := make([]map[string]interface{}, 0)
+	if len(responses.Contents) != 0 {
+		rd.Set(common.ToSnakeCase("IsSnapshotPolicy"), responses.Contents[0].IsSnapshotPolicy)
+		rd.Set(common.ToSnakeCase("SnapshotCapacityRate"), responses.Contents[0].SnapshotCapacityRate)
+		contents = common.ConvertStructToMaps(responses.Contents[0].Snapshots)
+	}
+
+	rd.SetId(uuid.NewV4().String())
+	rd.Set("contents", contents)
+	rd.Set("total_count", len(contents))
+
+	return nil
+}
+
+func bmBlockStorageSnapshotsElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			common.ToSnakeCase("SnapshotId"):    {Type: schema.TypeString, Computed: true, Description: "Snapshot Id"},
+			common.ToSnakeCase("SnapshotName"):  {Type: schema.TypeString, Computed: true, Description: "Snapshot Name"},
+			common.ToSnakeCase("SnapshotState"): {Type: schema.TypeString, Computed: true, Description: "Snapshot State"},
+			common.ToSnakeCase("CreatedBy"):     {Type: schema.TypeString, Computed: true, Description: "Created By"},
+			common.ToSnakeCase("CreatedDt"):     {Type: schema.TypeString, Computed: true, Description: "Created Date"},
+		},
+	}
+}
@@ -0,0 +1,360 @@
+package virtualserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/client/virtualserver"
+	"github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/common"
+	tfTags "github.com/SamsungSDSCloud/terraform-provider-samsungcloudplatform/v3/samsungcloudplatform/service/tag"
+	"github.com/SamsungSDSCloud/terraform-sdk-samsungcloudplatform/v3/library/image2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The build script runs in its own bash process with errexit. The build server powers itself off only when the
+// script exits with 0, which is the completion marker of the build. The power state is the only result the build
+// server can report, so a failed script leaves the server running and the build fails after build_timeout_minutes
+// instead of capturing a half-built server.
+const imageBuildScriptTemplate = `cat > /tmp/scp-image-build.sh <<'SCP_IMAGE_BUILD_EOF'
+%s
+SCP_IMAGE_BUILD_EOF
+if bash -e /tmp/scp-image-build.sh > /var/log/scp-image-build.log 2>&1; then
+  rm -f /tmp/scp-image-build.sh
+  sync
+  shutdown -h now
+else
+  echo "image build script failed with exit code $?" >> /var/log/scp-image-build.log
+fi
+`
+
+func init() {
+	samsungcloudplatform.RegisterResource("Image", "samsungcloudplatform_image_build", ResourceImageBuild())
+}
+
+func ResourceImageBuild() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImageBuildCreate,
+		ReadContext:   resourceImageBuildRead,
+		UpdateContext: resourceImageBuildUpdate,
+		DeleteContext: resourceImageBuildDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"image_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Name of the custom image to build.",
+				ValidateDiagFunc: common.ValidateName3to60AlphaNumericWithSpaceDashUnderscoreStartsWithLowerAlpha,
+			},
+			"image_description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Custom image description.",
+				ValidateDiagFunc: common.ValidateDescriptionMaxlength50,
+			},
+			"base_image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Linux standard or custom image id the build server is launched from.",
+			},
+			"build_script": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Bash script run on the build server with errexit. The server is powered off only when the script succeeds, which marks the build as complete.",
+			},
+			"build_timeout_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 1440),
+				Description:  "Minutes to wait for the build script to power off the build server. A failed script leaves the server running, so the build fails when this time passes.",
+			},
+			"server_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Server type of the build server (s1v1m2,..)",
+			},
+			"os_storage_size_gb": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          100,
+				Description:      "OS(Boot) storage size of the build server in gigabytes. (At least 100 GB required and size must be multiple of 10)",
+				ValidateDiagFunc: common.ValidateBlockStorageSizeForOS,
+			},
+			"os_storage_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "SSD",
+				Description: "OS(Boot) storage type of the build server",
+			},
+			"key_pair_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key pair id of the build server",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "VPC id of the build server",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Subnet id of the build server",
+			},
+			"security_group_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Security group ids of the build server",
+			},
+			"nat_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Enable NAT on the build server so that the build script can reach the internet",
+			},
+			"tags": tfTags.TagsSchema(),
+			"build_inputs_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 hash of the build inputs (base image, build script, server type and OS storage)",
+			},
+			"build_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the temporary build server. The server is deleted after the image is captured.",
+			},
+			"image_id":    {Type: schema.TypeString, Computed: true},
+			"image_state": {Type: schema.TypeString, Computed: true, Description: "Image state (ACTIVE)"},
+			"os_type":     {Type: schema.TypeString, Computed: true, Description: "OS type (Windows, Ubuntu, ..)"},
+			"created_dt":  {Type: schema.TypeString, Computed: true},
+		},
+		Description: "Builds a Custom Image from a base image and a build script. A temporary virtual server is launched, the script runs until the server powers itself off on success, the custom image is captured and the server is deleted.",
+	}
+}
+
+func getImageBuildInputsHash(rd *schema.ResourceData) string {
+	inputs := strings.Join([]string{
+		rd.Get("base_image_id").(string),
+		rd.Get("build_script").(string),
+		rd.Get("server_type").(string),
+		strconv.Itoa(rd.Get("os_storage_size_gb").(int)),
+		rd.Get("os_storage_type").(string),
+	}, "\n")
+	hash := sha256.Sum256([]byte(inputs))
+	return hex.EncodeToString(hash[:])
+}
+
+func resourceImageBuildCreate(ctx context.Context, rd *schema.ResourceData, meta interface{}) (diagnostics diag.Diagnostics) {
+	inst := meta.(*client.Instance)
+
+	vpcInfo, _, err := inst.Client.Vpc.GetVpcInfo(ctx, rd.Get("vpc_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	buildServerName := "imgbuild-" + strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 36)
+
+	createResponse, err := inst.Client.VirtualServer.CreateVirtualServerV4(ctx, virtualserver.CreateRequest{
+		BlockStorage: virtualserver.BlockStorageInfo{
+			BlockStorageName: buildServerName + "-os",
+			DiskSize:         int32(rd.Get("os_storage_size_gb").(int)),
+			DiskType:         rd.Get("os_storage_type").(string),
+		},
+		ImageId: rd.Get("base_image_id").(string),
+		InitialScript: virtualserver.InitialScriptInfo{
+			EncodingType:         "plain",
+			InitialScriptContent: getImageBuildScript(rd.Get("build_script").(string)),
+			InitialScriptShell:   "bash",
+			InitialScriptType:    "text",
+		},
+		Nic: virtualserver.NicInfo{
+			NatEnabled: rd.Get("nat_enabled").(bool),
+			SubnetId:   rd.Get("subnet_id").(string),
+		},
+		SecurityGroupIds:  getSecurityGroupIds(rd),
+		ServerType:        rd.Get("server_type").(string),
+		ServiceZoneId:     vpcInfo.ServiceZoneId,
+		VirtualServerName: buildServerName,
+		KeyPairId:         rd.Get("key_pair_id").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	buildServerId := createResponse.ResourceId
+	rd.Set("build_server_id", buildServerId)
+
+	// 빌드 성공/실패와 관계없이 임시 서버는 삭제
+	defer func() {
+		err := deleteImageBuildServer(ctx, inst.Client, buildServerId)
+		if err != nil {
+			diagnostics = append(diagnostics, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to delete build server %s", buildServerId),
+				Detail:   err.Error(),
+			})
+		}
+	}()
+
+	log.Printf("[INFO] Image build %s : waiting for build server %s to run the build script", rd.Get("image_name").(string), buildServerId)
+
+	buildTimeoutMinutes := rd.Get("build_timeout_minutes").(int)
+	buildCtx, cancel := context.WithTimeout(ctx, time.Duration(buildTimeoutMinutes)*time.Minute)
+	defer cancel()
+
+	err = client.WaitForStatus(buildCtx, inst.Client, []string{common.CreatingState, common.EditingState, common.StartingState, common.RunningState, common.StoppingState}, []string{common.StoppedState, common.ErrorState}, func() (interface{}, string, error) {
+		info, _, err := inst.Client.VirtualServer.GetVirtualServer(buildCtx, buildServerId)
+		if err != nil {
+			return nil, "", err
+		}
+		return info, info.VirtualServerState, nil
+	})
+	if err != nil {
+		return diag.Errorf("build server %s did not power off within %d minutes. The build script failed or did not finish : %s", buildServerId, buildTimeoutMinutes, err)
+	}
+
+	info, _, err := inst.Client.VirtualServer.GetVirtualServer(ctx, buildServerId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if info.VirtualServerState == common.ErrorState {
+		return diag.Errorf("build server %s is in %s state", buildServerId, common.ErrorState)
+	}
+
+	log.Printf("[INFO] Image build %s : capturing custom image from build server %s", rd.Get("image_name").(string), buildServerId)
+
+	imageResponse, _, err := inst.Client.CustomImage.CreateCustomImage(ctx, image2.CustomImageCreateRequest{
+		ImageName:        rd.Get("image_name").(string),
+		VirtualServerId:  buildServerId,
+		ImageDescription: rd.Get("image_description").(string),
+	}, rd.Get("tags").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rd.SetId(imageResponse.ResourceId)
+	rd.Set("build_inputs_hash", getImageBuildInputsHash(rd))
+
+	err = waitForImageBuildStatus(ctx, inst.Client, imageResponse.ResourceId, []string{common.CreatingState}, []string{common.ActiveState}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceImageBuildRead(ctx, rd, meta)
+}
+
+func getImageBuildScript(buildScript string) string {
+	return fmt.Sprintf(imageBuildScriptTemplate, strings.TrimRight(buildScript, "\n"))
+}
+
+func deleteImageBuildServer(ctx context.Context, scpClient *client.SCPClient, buildServerId string) error {
+	err := WaitForVirtualServerStatus(ctx, scpClient, buildServerId, common.VirtualServerProcessingStates(), []string{common.RunningState, common.StoppedState, common.ErrorState}, false)
+	if err != nil {
+		return err
+	}
+
+	_, err = scpClient.VirtualServer.DeleteVirtualServer(ctx, buildServerId)
+	if err != nil && !common.IsDeleted(err) {
+		return err
+	}
+
+	return WaitForVirtualServerStatus(ctx, scpClient, buildServerId, common.VirtualServerProcessingStates(), []string{common.DeletedState}, false)
+}
+
+func resourceImageBuildRead(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	info, _, err := inst.Client.CustomImage.GetCustomImage(ctx, rd.Id())
+	if err != nil {
+		rd.SetId("")
+		if common.IsDeleted(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	rd.Set("image_id", info.ImageId)
+	rd.Set("image_description", info.ImageDescription)
+	rd.Set("image_state", info.ImageState)
+	rd.Set("os_type", info.OsType)
+	rd.Set("created_dt", info.CreatedDt.String())
+
+	tfTags.SetTags(ctx, rd, meta, rd.Id())
+
+	return nil
+}
+
+func resourceImageBuildUpdate(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	if rd.HasChanges("image_description") {
+		_, err := inst.Client.CustomImage.UpdateCustomImageDescription(ctx, rd.Id(), rd.Get("image_description").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := tfTags.UpdateTags(ctx, rd, meta, rd.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceImageBuildRead(ctx, rd, meta)
+}
+
+func resourceImageBuildDelete(ctx context.Context, rd *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	inst := meta.(*client.Instance)
+
+	err := inst.Client.CustomImage.DeleteCustomImage(ctx, rd.Id())
+	if err != nil && !common.IsDeleted(err) {
+		return diag.FromErr(err)
+	}
+
+	err = waitForImageBuildStatus(ctx, inst.Client, rd.Id(), []string{}, []string{common.DeletedState}, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func waitForImageBuildStatus(ctx context.Context, scpClient *client.SCPClient, id string, pendingStates []string, targetStates []string, errorOnNotFound bool) error {
+	return client.WaitForStatus(ctx, scpClient, pendingStates, targetStates, func() (interface{}, string, error) {
+		info, c, err := scpClient.CustomImage.GetCustomImage(ctx, id)
+		if err != nil {
+			if c == 404 && !errorOnNotFound {
+				return "", common.DeletedState, nil
+			}
+			if c == 403 && !errorOnNotFound {
+				return "", common.DeletedState, nil
+			}
+			return nil, "", err
+		}
+		return info, info.ImageState, nil
+	})
+}
@@ -73,8 +73,7 @@ func ResourceVirtualServer() *schema.Resource {
 			"server_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     false,
-				Description: "Server Group Id for Anti-affinity",
+				Description: "Server Group Id for Anti-affinity. The first anti-affinity server group is used when not set.",
 			},
 			"cpu_count": {
 				Type:             schema.TypeInt,
@@ -223,6 +222,7 @@ func ResourceVirtualServer() *schema.Resource {
 			"placement_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Placement Group Id",
 			},
 			"ipv4": {
@@ -240,7 +240,10 @@ func ResourceVirtualServer() *schema.Resource {
 				Optional:    true,
 				Description: "Availability Zone Name",
 			},
-			"tags": tfTags.TagsSchema(),
+			"contract":          common.ContractSchema(),
+			"next_contract":     common.NextContractSchema(),
+			"contract_end_date": common.ContractEndDateSchema(),
+			"tags":              tfTags.TagsSchema(),
 			"role_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -450,13 +453,12 @@ func resourceVirtualServerCreate(ctx context.Context, rd *schema.ResourceData, m
 		log.Println("contents : ", serverGroupList.Contents)
 		for _, sg := range serverGroupList.Contents {
 			if sg.AffinityPolicyType != "NONE" {
-				if inputtedServerGroupId != "" {
+				if inputtedServerGroupId == "" {
+					serverGroupId = sg.ServerGroupId
+					break
+				} else if sg.ServerGroupId == inputtedServerGroupId {
 					serverGroupId = sg.ServerGroupId
 					break
-				} else {
-					if sg.ServerGroupId == inputtedServerGroupId {
-						serverGroupId = sg.ServerGroupId
-					}
 				}
 			}
 		}
@@ -604,6 +606,12 @@ func resourceVirtualServerCreate(ctx context.Context, rd *schema.ResourceData, m
 
 	rd.SetId(createResponse.ResourceId)
 
+	// 생성 API 는 약정을 받지 않으므로 생성 후 변경한다.
+	err = updateVirtualServerContract(ctx, rd, inst, createResponse.ResourceId, &productGroup)
+	if err != nil {
+		return
+	}
+
 	return resourceVirtualServerRead(ctx, rd, meta)
 }
 
@@ -837,16 +845,15 @@ func resourceVirtualServerRead(ctx context.Context, rd *schema.ResourceData, met
 	rd.Set("virtual_server_name", virtualServerInfo.VirtualServerName)
 	rd.Set("delete_protection", virtualServerInfo.DeletionProtectionEnabled)
 	//rd.Set("service_level", virtualServerInfo.ServiceLevel)
-	rd.Set("contract_discount", virtualServerInfo.Contract)
+	nextContract := "None"
 	if len(virtualServerInfo.NextContractId) > 0 {
 		info, err := inst.Client.Product.GetProductsUsingGET(ctx, virtualServerInfo.NextContractId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		rd.Set("next_contract_discount", info.Name)
-	} else {
-		rd.Set("next_contract_discount", "None")
+		nextContract = info.Name
 	}
+	common.SetContract(rd, virtualServerInfo.Contract, nextContract, virtualServerInfo.ContractEndDate)
 	rd.Set("vpc_id", virtualServerInfo.VpcId)
 	rd.Set("use_dns", virtualServerInfo.DnsEnabled)
 	rd.Set("initial_script_content", virtualServerInfo.InitialScriptContent)
@@ -1085,6 +1092,32 @@ func getBlockStorageResponseList(ctx context.Context, blockStorageIds []string,
 	return blockStorageResponseList
 }
 
+func updateVirtualServerContract(ctx context.Context, rd *schema.ResourceData, inst *client.Instance, virtualServerId string, productGroup *product.ProductGroupDetailResponse) error {
+	if common.HasContractChange(rd, "contract") {
+		contractId, err := common.FindContractProductId(rd.Get("contract").(string), productGroup)
+		if err != nil {
+			return err
+		}
+		_, _, err = inst.Client.VirtualServer.UpdateContract(ctx, virtualServerId, contractId)
+		if err != nil {
+			return err
+		}
+	}
+
+	if common.HasContractChange(rd, "next_contract") {
+		nextContractId, err := common.FindContractProductId(rd.Get("next_contract").(string), productGroup)
+		if err != nil {
+			return err
+		}
+		_, _, err = inst.Client.VirtualServer.UpdateNextContract(ctx, virtualServerId, nextContractId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceVirtualServerUpdate(ctx context.Context, rd *schema.ResourceData, meta interface{}) (diagnostics diag.Diagnostics) {
 	var err error = nil
 	defer func() {
@@ -1163,6 +1196,17 @@ func resourceVirtualServerUpdate(ctx context.Context, rd *schema.ResourceData, m
 		}
 	}
 
+	if rd.HasChanges("contract", "next_contract") {
+		productGroup, err := inst.Client.Product.GetProductGroup(ctx, targetProductGroupId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = updateVirtualServerContract(ctx, rd, inst, rd.Id(), &productGroup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if rd.HasChanges("security_group_ids") {
 		securityGroupIds := getSecurityGroupIds(rd)
 		currentSGData := make(map[string]bool)